@@ -18,6 +18,9 @@ const (
 	// tenantProcessedConditionType is the type used to track the status of a Release Tenant Pipeline processing
 	tenantProcessedConditionType conditions.ConditionType = "TenantPipelineProcessed"
 
+	// queuedConditionType is the type used to track whether a Release is waiting to create its Managed PipelineRun
+	queuedConditionType conditions.ConditionType = "Queued"
+
 	// releasedConditionType is the type used to track the status of a Release
 	releasedConditionType conditions.ConditionType = "Released"
 
@@ -29,12 +32,24 @@ const (
 	// FailedReason is the reason set when a failure occurs
 	FailedReason conditions.ConditionReason = "Failed"
 
+	// MissingReleasePlanAdmissionReason is the reason set when a Release is waiting for its ReleasePlanAdmission to be created
+	MissingReleasePlanAdmissionReason conditions.ConditionReason = "MissingReleasePlanAdmission"
+
+	// PipelineRunDeletedReason is the reason set when a PipelineRun is deleted before finishing
+	PipelineRunDeletedReason conditions.ConditionReason = "PipelineRunDeleted"
+
 	// ProgressingReason is the reason set when a phase is progressing
 	ProgressingReason conditions.ConditionReason = "Progressing"
 
+	// QueuedReason is the reason set when a Release is waiting for a free slot to create its Managed PipelineRun
+	QueuedReason conditions.ConditionReason = "Queued"
+
 	// SkippedReason is the reason set when a phase is skipped
 	SkippedReason conditions.ConditionReason = "Skipped"
 
 	// SucceededReason is the reason set when a phase succeeds
 	SucceededReason conditions.ConditionReason = "Succeeded"
+
+	// TimeoutReason is the reason set when a Release is not processed before its deadline
+	TimeoutReason conditions.ConditionReason = "Timeout"
 )
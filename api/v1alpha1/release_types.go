@@ -23,6 +23,7 @@ import (
 
 	"github.com/konflux-ci/release-service/metadata"
 	"github.com/konflux-ci/release-service/metrics"
+	tektonv1 "github.com/tektoncd/pipeline/pkg/apis/pipeline/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 
 	"k8s.io/apimachinery/pkg/api/meta"
@@ -50,6 +51,11 @@ type ReleaseSpec struct {
 	// This value is used to define the Release ExpirationTime
 	// +optional
 	GracePeriodDays int `json:"gracePeriodDays,omitempty"`
+
+	// Params is a list of params to pass to the tenant and final Pipelines. They take precedence over the params
+	// defined in the ReleasePlan with the same name
+	// +optional
+	Params []tektonv1.Param `json:"params,omitempty"`
 }
 
 // ReleaseStatus defines the observed state of Release.
@@ -59,6 +65,11 @@ type ReleaseStatus struct {
 	// +optional
 	Artifacts *runtime.RawExtension `json:"artifacts,omitempty"`
 
+	// Attempts is the number of times the Release processing was retried using the retry annotation. Unlike the Retries of
+	// the managed processing, it's never reset
+	// +optional
+	Attempts int `json:"attempts,omitempty"`
+
 	// Attribution contains information about the entity authorizing the release
 	// +optional
 	Attribution AttributionInfo `json:"attribution,omitempty"`
@@ -92,6 +103,12 @@ type ReleaseStatus struct {
 	// +optional
 	Validation ValidationInfo `json:"validation,omitempty"`
 
+	// Queue contains the namespaced name of the ReleasePlanAdmission whose PipelineRun concurrency limit the Release
+	// is waiting on to create its managed PipelineRun
+	// +kubebuilder:validation:Pattern=^[a-z0-9]([-a-z0-9]*[a-z0-9])?\/[a-z0-9]([-a-z0-9]*[a-z0-9])?$
+	// +optional
+	Queue string `json:"queue,omitempty"`
+
 	// Target references where this release is intended to be released to
 	// +kubebuilder:validation:Pattern=^[a-z0-9]([-a-z0-9]*[a-z0-9])?$
 	// +optional
@@ -109,9 +126,17 @@ type ReleaseStatus struct {
 	// +optional
 	StartTime *metav1.Time `json:"startTime,omitempty"`
 
+	// LastRetryTime is the time when the Release processing was last retried
+	// +optional
+	LastRetryTime *metav1.Time `json:"lastRetryTime,omitempty"`
+
 	// ExpirationTime is the time when a Release can be purged
 	// +optional
 	ExpirationTime *metav1.Time `json:"expirationTime,omitempty"`
+
+	// DeleteOnExpiration indicates whether the Release is deleted once it has finished and its ExpirationTime is reached
+	// +optional
+	DeleteOnExpiration bool `json:"deleteOnExpiration,omitempty"`
 }
 
 // AttributionInfo defines the observed state of the release attribution.
@@ -154,15 +179,31 @@ type RoleBindingType struct {
 
 // PipelineInfo defines the observed state of a release pipeline processing.
 type PipelineInfo struct {
+	// Attempt is the attempt number used to name the PipelineRun when deterministic names are enabled. It counts every
+	// PipelineRun created, including the automatic and the manual retries, and it's kept when the Release is retried so
+	// names don't clash
+	// +optional
+	Attempt int `json:"attempt,omitempty"`
+
 	// CompletionTime is the time when the Release processing was completed
 	// +optional
 	CompletionTime *metav1.Time `json:"completionTime,omitempty"`
 
+	// PipelineBundle contains the resolved reference, including its digest, of the Pipeline executed as part of this
+	// release
+	// +optional
+	PipelineBundle string `json:"pipelineBundle,omitempty"`
+
 	// PipelineRun contains the namespaced name of the managed Release PipelineRun executed as part of this release
 	// +kubebuilder:validation:Pattern=^[a-z0-9]([-a-z0-9]*[a-z0-9])?\/[a-z0-9]([-a-z0-9]*[a-z0-9])?$
 	// +optional
 	PipelineRun string `json:"pipelineRun,omitempty"`
 
+	// Retries is the number of times the PipelineRun was recreated automatically after failing. It's reset when the
+	// Release is retried using the retry annotation, so each attempt gets all the retries allowed
+	// +optional
+	Retries int `json:"retries,omitempty"`
+
 	// RoleBindings defines the roleBindings for accessing resources during the Release
 	// PipelineRun executed as part of this release.
 	// +optional
@@ -190,6 +231,8 @@ type ValidationInfo struct {
 // +kubebuilder:printcolumn:name="ReleasePlan",type=string,JSONPath=`.spec.releasePlan`
 // +kubebuilder:printcolumn:name="Release status",type=string,JSONPath=`.status.conditions[?(@.type=="Released")].reason`
 // +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+// +kubebuilder:printcolumn:name="StartTime",type=date,JSONPath=`.status.startTime`,priority=1
+// +kubebuilder:printcolumn:name="CompletionTime",type=date,JSONPath=`.status.completionTime`,priority=1
 
 // Release is the Schema for the releases API
 type Release struct {
@@ -200,6 +243,27 @@ type Release struct {
 	Status ReleaseStatus `json:"status,omitempty"`
 }
 
+// GetProcessingStartTime returns the time when the current attempt of the Release processing started, which is the
+// time of the last retry if the Release was retried or the time when the Release started otherwise.
+func (r *Release) GetProcessingStartTime() *metav1.Time {
+	if r.Status.LastRetryTime != nil {
+		return r.Status.LastRetryTime
+	}
+
+	return r.Status.StartTime
+}
+
+// GetValidationMessage returns the message of the Release validation condition, which explains why the validation
+// failed. An empty string is returned if the Release hasn't been validated yet.
+func (r *Release) GetValidationMessage() string {
+	condition := meta.FindStatusCondition(r.Status.Conditions, validatedConditionType.String())
+	if condition == nil {
+		return ""
+	}
+
+	return condition.Message
+}
+
 // HasFinalPipelineProcessingFinished checks whether the Release Final Pipeline processing has finished, regardless of the result.
 func (r *Release) HasFinalPipelineProcessingFinished() bool {
 	return r.hasPhaseFinished(finalProcessedConditionType)
@@ -230,6 +294,11 @@ func (r *Release) HasReleaseFinished() bool {
 	return r.hasPhaseFinished(releasedConditionType)
 }
 
+// HasExpired checks whether the Release has to be deleted because its ExpirationTime has been reached.
+func (r *Release) HasExpired() bool {
+	return r.Status.DeleteOnExpiration && r.Status.ExpirationTime != nil && !time.Now().Before(r.Status.ExpirationTime.Time)
+}
+
 // IsAttributed checks whether the Release was marked as attributed.
 func (r *Release) IsAttributed() bool {
 	return r.Status.Attribution.Author != ""
@@ -315,6 +384,11 @@ func (r *Release) IsTenantPipelineSkipped() bool {
 	return r.isPhaseSkipped(tenantProcessedConditionType)
 }
 
+// IsQueued checks whether the Release is waiting for a free slot to create its Managed PipelineRun.
+func (r *Release) IsQueued() bool {
+	return meta.IsStatusConditionTrue(r.Status.Conditions, queuedConditionType.String())
+}
+
 // IsReleased checks whether the Release has finished successfully.
 func (r *Release) IsReleased() bool {
 	return meta.IsStatusConditionTrue(r.Status.Conditions, releasedConditionType.String())
@@ -325,6 +399,19 @@ func (r *Release) IsReleasing() bool {
 	return r.isPhaseProgressing(releasedConditionType)
 }
 
+// IsReleasePlanAdmissionMissing checks whether the Release validation is waiting for its ReleasePlanAdmission to be created.
+func (r *Release) IsReleasePlanAdmissionMissing() bool {
+	condition := meta.FindStatusCondition(r.Status.Conditions, validatedConditionType.String())
+	return condition != nil && condition.Status == metav1.ConditionFalse &&
+		condition.Reason == MissingReleasePlanAdmissionReason.String()
+}
+
+// IsRetriable checks whether the Release can be retried, which is only possible when it failed on the Managed Pipeline
+// and the Managed Pipeline processing has finished.
+func (r *Release) IsRetriable() bool {
+	return r.IsFailed() && r.HasManagedPipelineProcessingFinished() && !r.IsManagedPipelineProcessedSuccessfully()
+}
+
 // IsValid checks whether the Release validation has finished successfully.
 func (r *Release) IsValid() bool {
 	return meta.IsStatusConditionTrue(r.Status.Conditions, validatedConditionType.String())
@@ -333,7 +420,19 @@ func (r *Release) IsValid() bool {
 // IsFailed checks whether the Release has failed.
 func (r *Release) IsFailed() bool {
 	condition := meta.FindStatusCondition(r.Status.Conditions, releasedConditionType.String())
-	return condition != nil && condition.Status == metav1.ConditionFalse && condition.Reason == FailedReason.String()
+	return condition != nil && condition.Status == metav1.ConditionFalse &&
+		(condition.Reason == FailedReason.String() || condition.Reason == PipelineRunDeletedReason.String() ||
+			condition.Reason == TimeoutReason.String())
+}
+
+// MarkDequeued marks the Release as no longer waiting to create its Managed PipelineRun.
+func (r *Release) MarkDequeued() {
+	if !r.IsQueued() {
+		return
+	}
+
+	r.Status.Queue = ""
+	r.setCondition(queuedConditionType, metav1.ConditionFalse, ProgressingReason, "")
 }
 
 // MarkFinalPipelineProcessed marks the Release Final Pipeline as processed.
@@ -343,7 +442,7 @@ func (r *Release) MarkFinalPipelineProcessed() {
 	}
 
 	r.Status.FinalProcessing.CompletionTime = &metav1.Time{Time: time.Now()}
-	conditions.SetCondition(&r.Status.Conditions, finalProcessedConditionType, metav1.ConditionTrue, SucceededReason)
+	r.setCondition(finalProcessedConditionType, metav1.ConditionTrue, SucceededReason, "")
 
 	go metrics.RegisterCompletedReleasePipelineProcessing(
 		r.Status.FinalProcessing.StartTime,
@@ -361,7 +460,7 @@ func (r *Release) MarkManagedCollectorsPipelineProcessed() {
 	}
 
 	r.Status.CollectorsProcessing.ManagedCollectorsProcessing.CompletionTime = &metav1.Time{Time: time.Now()}
-	conditions.SetCondition(&r.Status.Conditions, managedCollectorsProcessedConditionType, metav1.ConditionTrue, SucceededReason)
+	r.setCondition(managedCollectorsProcessedConditionType, metav1.ConditionTrue, SucceededReason, "")
 
 	go metrics.RegisterCompletedReleasePipelineProcessing(
 		r.Status.CollectorsProcessing.ManagedCollectorsProcessing.StartTime,
@@ -379,7 +478,7 @@ func (r *Release) MarkManagedPipelineProcessed() {
 	}
 
 	r.Status.ManagedProcessing.CompletionTime = &metav1.Time{Time: time.Now()}
-	conditions.SetCondition(&r.Status.Conditions, managedProcessedConditionType, metav1.ConditionTrue, SucceededReason)
+	r.setCondition(managedProcessedConditionType, metav1.ConditionTrue, SucceededReason, "")
 
 	go metrics.RegisterCompletedReleasePipelineProcessing(
 		r.Status.ManagedProcessing.StartTime,
@@ -397,7 +496,7 @@ func (r *Release) MarkTenantCollectorsPipelineProcessed() {
 	}
 
 	r.Status.CollectorsProcessing.TenantCollectorsProcessing.CompletionTime = &metav1.Time{Time: time.Now()}
-	conditions.SetCondition(&r.Status.Conditions, tenantCollectorsProcessedConditionType, metav1.ConditionTrue, SucceededReason)
+	r.setCondition(tenantCollectorsProcessedConditionType, metav1.ConditionTrue, SucceededReason, "")
 
 	go metrics.RegisterCompletedReleasePipelineProcessing(
 		r.Status.CollectorsProcessing.TenantCollectorsProcessing.StartTime,
@@ -415,7 +514,7 @@ func (r *Release) MarkTenantPipelineProcessed() {
 	}
 
 	r.Status.TenantProcessing.CompletionTime = &metav1.Time{Time: time.Now()}
-	conditions.SetCondition(&r.Status.Conditions, tenantProcessedConditionType, metav1.ConditionTrue, SucceededReason)
+	r.setCondition(tenantProcessedConditionType, metav1.ConditionTrue, SucceededReason, "")
 
 	go metrics.RegisterCompletedReleasePipelineProcessing(
 		r.Status.TenantProcessing.StartTime,
@@ -436,7 +535,7 @@ func (r *Release) MarkFinalPipelineProcessing() {
 		r.Status.FinalProcessing.StartTime = &metav1.Time{Time: time.Now()}
 	}
 
-	conditions.SetCondition(&r.Status.Conditions, finalProcessedConditionType, metav1.ConditionFalse, ProgressingReason)
+	r.setCondition(finalProcessedConditionType, metav1.ConditionFalse, ProgressingReason, "")
 
 	go metrics.RegisterNewReleasePipelineProcessing(
 		r.Status.StartTime,
@@ -457,7 +556,7 @@ func (r *Release) MarkManagedCollectorsPipelineProcessing() {
 		r.Status.CollectorsProcessing.ManagedCollectorsProcessing.StartTime = &metav1.Time{Time: time.Now()}
 	}
 
-	conditions.SetCondition(&r.Status.Conditions, managedCollectorsProcessedConditionType, metav1.ConditionFalse, ProgressingReason)
+	r.setCondition(managedCollectorsProcessedConditionType, metav1.ConditionFalse, ProgressingReason, "")
 
 	go metrics.RegisterNewReleasePipelineProcessing(
 		r.Status.StartTime,
@@ -478,7 +577,7 @@ func (r *Release) MarkManagedPipelineProcessing() {
 		r.Status.ManagedProcessing.StartTime = &metav1.Time{Time: time.Now()}
 	}
 
-	conditions.SetCondition(&r.Status.Conditions, managedProcessedConditionType, metav1.ConditionFalse, ProgressingReason)
+	r.setCondition(managedProcessedConditionType, metav1.ConditionFalse, ProgressingReason, "")
 
 	go metrics.RegisterNewReleasePipelineProcessing(
 		r.Status.StartTime,
@@ -499,7 +598,7 @@ func (r *Release) MarkTenantCollectorsPipelineProcessing() {
 		r.Status.CollectorsProcessing.TenantCollectorsProcessing.StartTime = &metav1.Time{Time: time.Now()}
 	}
 
-	conditions.SetCondition(&r.Status.Conditions, tenantCollectorsProcessedConditionType, metav1.ConditionFalse, ProgressingReason)
+	r.setCondition(tenantCollectorsProcessedConditionType, metav1.ConditionFalse, ProgressingReason, "")
 
 	go metrics.RegisterNewReleasePipelineProcessing(
 		r.Status.StartTime,
@@ -520,7 +619,7 @@ func (r *Release) MarkTenantPipelineProcessing() {
 		r.Status.TenantProcessing.StartTime = &metav1.Time{Time: time.Now()}
 	}
 
-	conditions.SetCondition(&r.Status.Conditions, tenantProcessedConditionType, metav1.ConditionFalse, ProgressingReason)
+	r.setCondition(tenantProcessedConditionType, metav1.ConditionFalse, ProgressingReason, "")
 
 	go metrics.RegisterNewReleasePipelineProcessing(
 		r.Status.StartTime,
@@ -538,7 +637,7 @@ func (r *Release) MarkFinalPipelineProcessingFailed(message string) {
 	}
 
 	r.Status.FinalProcessing.CompletionTime = &metav1.Time{Time: time.Now()}
-	conditions.SetConditionWithMessage(&r.Status.Conditions, finalProcessedConditionType, metav1.ConditionFalse, FailedReason, message)
+	r.setCondition(finalProcessedConditionType, metav1.ConditionFalse, FailedReason, message)
 
 	go metrics.RegisterCompletedReleasePipelineProcessing(
 		r.Status.FinalProcessing.StartTime,
@@ -556,7 +655,7 @@ func (r *Release) MarkManagedCollectorsPipelineProcessingFailed(message string)
 	}
 
 	r.Status.CollectorsProcessing.ManagedCollectorsProcessing.CompletionTime = &metav1.Time{Time: time.Now()}
-	conditions.SetConditionWithMessage(&r.Status.Conditions, managedCollectorsProcessedConditionType, metav1.ConditionFalse, FailedReason, message)
+	r.setCondition(managedCollectorsProcessedConditionType, metav1.ConditionFalse, FailedReason, message)
 
 	go metrics.RegisterCompletedReleasePipelineProcessing(
 		r.Status.CollectorsProcessing.ManagedCollectorsProcessing.StartTime,
@@ -574,7 +673,7 @@ func (r *Release) MarkManagedPipelineProcessingFailed(message string) {
 	}
 
 	r.Status.ManagedProcessing.CompletionTime = &metav1.Time{Time: time.Now()}
-	conditions.SetConditionWithMessage(&r.Status.Conditions, managedProcessedConditionType, metav1.ConditionFalse, FailedReason, message)
+	r.setCondition(managedProcessedConditionType, metav1.ConditionFalse, FailedReason, message)
 
 	go metrics.RegisterCompletedReleasePipelineProcessing(
 		r.Status.ManagedProcessing.StartTime,
@@ -592,7 +691,7 @@ func (r *Release) MarkTenantCollectorsPipelineProcessingFailed(message string) {
 	}
 
 	r.Status.CollectorsProcessing.TenantCollectorsProcessing.CompletionTime = &metav1.Time{Time: time.Now()}
-	conditions.SetConditionWithMessage(&r.Status.Conditions, tenantCollectorsProcessedConditionType, metav1.ConditionFalse, FailedReason, message)
+	r.setCondition(tenantCollectorsProcessedConditionType, metav1.ConditionFalse, FailedReason, message)
 
 	go metrics.RegisterCompletedReleasePipelineProcessing(
 		r.Status.CollectorsProcessing.TenantCollectorsProcessing.StartTime,
@@ -610,7 +709,7 @@ func (r *Release) MarkTenantPipelineProcessingFailed(message string) {
 	}
 
 	r.Status.TenantProcessing.CompletionTime = &metav1.Time{Time: time.Now()}
-	conditions.SetConditionWithMessage(&r.Status.Conditions, tenantProcessedConditionType, metav1.ConditionFalse, FailedReason, message)
+	r.setCondition(tenantProcessedConditionType, metav1.ConditionFalse, FailedReason, message)
 
 	go metrics.RegisterCompletedReleasePipelineProcessing(
 		r.Status.TenantProcessing.StartTime,
@@ -627,7 +726,7 @@ func (r *Release) MarkFinalPipelineProcessingSkipped() {
 		return
 	}
 
-	conditions.SetCondition(&r.Status.Conditions, finalProcessedConditionType, metav1.ConditionTrue, SkippedReason)
+	r.setCondition(finalProcessedConditionType, metav1.ConditionTrue, SkippedReason, "")
 }
 
 // MarkManagedCollectorsPipelineProcessingSkipped marks the Release Managed Collectors Pipeline processing as skipped.
@@ -636,7 +735,7 @@ func (r *Release) MarkManagedCollectorsPipelineProcessingSkipped() {
 		return
 	}
 
-	conditions.SetCondition(&r.Status.Conditions, managedCollectorsProcessedConditionType, metav1.ConditionTrue, SkippedReason)
+	r.setCondition(managedCollectorsProcessedConditionType, metav1.ConditionTrue, SkippedReason, "")
 }
 
 // MarkManagedPipelineProcessingSkipped marks the Release Managed Pipeline processing as skipped.
@@ -645,7 +744,7 @@ func (r *Release) MarkManagedPipelineProcessingSkipped() {
 		return
 	}
 
-	conditions.SetCondition(&r.Status.Conditions, managedProcessedConditionType, metav1.ConditionTrue, SkippedReason)
+	r.setCondition(managedProcessedConditionType, metav1.ConditionTrue, SkippedReason, "")
 }
 
 // MarkTenantCollectorsPipelineProcessingSkipped marks the Release Tenant Collectors Pipeline processing as skipped.
@@ -654,7 +753,7 @@ func (r *Release) MarkTenantCollectorsPipelineProcessingSkipped() {
 		return
 	}
 
-	conditions.SetCondition(&r.Status.Conditions, tenantCollectorsProcessedConditionType, metav1.ConditionTrue, SkippedReason)
+	r.setCondition(tenantCollectorsProcessedConditionType, metav1.ConditionTrue, SkippedReason, "")
 }
 
 // MarkTenantPipelineProcessingSkipped marks the Release Tenant Pipeline processing as skipped.
@@ -663,7 +762,17 @@ func (r *Release) MarkTenantPipelineProcessingSkipped() {
 		return
 	}
 
-	conditions.SetCondition(&r.Status.Conditions, tenantProcessedConditionType, metav1.ConditionTrue, SkippedReason)
+	r.setCondition(tenantProcessedConditionType, metav1.ConditionTrue, SkippedReason, "")
+}
+
+// MarkQueued marks the Release as waiting for a free slot in the given queue to create its Managed PipelineRun.
+func (r *Release) MarkQueued(queue, message string) {
+	if !r.IsReleasing() || r.HasReleaseFinished() {
+		return
+	}
+
+	r.Status.Queue = queue
+	r.setCondition(queuedConditionType, metav1.ConditionTrue, QueuedReason, message)
 }
 
 // MarkReleased marks the Release as released.
@@ -673,7 +782,7 @@ func (r *Release) MarkReleased() {
 	}
 
 	r.Status.CompletionTime = &metav1.Time{Time: time.Now()}
-	conditions.SetCondition(&r.Status.Conditions, releasedConditionType, metav1.ConditionTrue, SucceededReason)
+	r.setCondition(releasedConditionType, metav1.ConditionTrue, SucceededReason, "")
 
 	go metrics.RegisterCompletedRelease(
 		r.Status.StartTime,
@@ -699,19 +808,24 @@ func (r *Release) MarkReleasing(message string) {
 		r.Status.StartTime = &metav1.Time{Time: time.Now()}
 	}
 
-	conditions.SetConditionWithMessage(&r.Status.Conditions, releasedConditionType, metav1.ConditionFalse, ProgressingReason, message)
+	r.setCondition(releasedConditionType, metav1.ConditionFalse, ProgressingReason, message)
 
 	go metrics.RegisterNewRelease()
 }
 
 // MarkReleaseFailed marks the Release as failed.
 func (r *Release) MarkReleaseFailed(message string) {
+	r.MarkReleaseFailedWithReason(FailedReason, message)
+}
+
+// MarkReleaseFailedWithReason marks the Release as failed using the given reason.
+func (r *Release) MarkReleaseFailedWithReason(reason conditions.ConditionReason, message string) {
 	if !r.IsReleasing() || r.HasReleaseFinished() {
 		return
 	}
 
 	r.Status.CompletionTime = &metav1.Time{Time: time.Now()}
-	conditions.SetConditionWithMessage(&r.Status.Conditions, releasedConditionType, metav1.ConditionFalse, FailedReason, message)
+	r.setCondition(releasedConditionType, metav1.ConditionFalse, reason, message)
 
 	go metrics.RegisterCompletedRelease(
 		r.Status.StartTime,
@@ -721,12 +835,43 @@ func (r *Release) MarkReleaseFailed(message string) {
 		r.getPhaseReason(managedCollectorsProcessedConditionType),
 		r.getPhaseReason(managedProcessedConditionType),
 		r.getPhaseReason(finalProcessedConditionType),
-		FailedReason.String(),
+		reason.String(),
 		r.Status.Target,
 		r.getPhaseReason(validatedConditionType),
 	)
 }
 
+// MarkReleasePlanAdmissionMissing marks the Release validation as waiting for its ReleasePlanAdmission to be created.
+// Unlike MarkValidationFailed, the validation is expected to be retried, so no validation time is registered.
+func (r *Release) MarkReleasePlanAdmissionMissing(message string) {
+	if r.IsValid() {
+		return
+	}
+
+	r.setCondition(validatedConditionType, metav1.ConditionFalse, MissingReleasePlanAdmissionReason, message)
+}
+
+// MarkRetried resets the Managed and Final Pipeline processing of a failed Release so it can be performed again and
+// marks the Release as releasing, increasing the number of attempts. The automatic retries of the managed processing
+// are reset so the new attempt can be retried as many times as the original one, while its PipelineRun attempt number
+// is kept. The retry time is registered so the processing deadline of the new attempt is not measured from the start
+// of the original one.
+func (r *Release) MarkRetried() {
+	if !r.IsFailed() {
+		return
+	}
+
+	r.Status.Attempts++
+	r.Status.CompletionTime = nil
+	r.Status.LastRetryTime = &metav1.Time{Time: time.Now()}
+	r.Status.FinalProcessing = PipelineInfo{}
+	// The attempt is kept so deterministic PipelineRun names don't clash with the ones used before the retry
+	r.Status.ManagedProcessing = PipelineInfo{Attempt: r.Status.ManagedProcessing.Attempt}
+	meta.RemoveStatusCondition(&r.Status.Conditions, finalProcessedConditionType.String())
+	meta.RemoveStatusCondition(&r.Status.Conditions, managedProcessedConditionType.String())
+	r.setCondition(releasedConditionType, metav1.ConditionFalse, ProgressingReason, "")
+}
+
 // MarkValidated marks the Release as validated.
 func (r *Release) MarkValidated() {
 	if r.IsValid() {
@@ -734,7 +879,7 @@ func (r *Release) MarkValidated() {
 	}
 
 	r.Status.Validation.Time = &metav1.Time{Time: time.Now()}
-	conditions.SetCondition(&r.Status.Conditions, validatedConditionType, metav1.ConditionTrue, SucceededReason)
+	r.setCondition(validatedConditionType, metav1.ConditionTrue, SucceededReason, "")
 
 	go metrics.RegisterValidatedRelease(
 		r.Status.StartTime,
@@ -751,7 +896,7 @@ func (r *Release) MarkValidationFailed(message string) {
 	}
 
 	r.Status.Validation.Time = &metav1.Time{Time: time.Now()}
-	conditions.SetConditionWithMessage(&r.Status.Conditions, validatedConditionType, metav1.ConditionFalse, FailedReason, message)
+	r.setCondition(validatedConditionType, metav1.ConditionFalse, FailedReason, message)
 
 	go metrics.RegisterValidatedRelease(
 		r.Status.StartTime,
@@ -776,6 +921,13 @@ func (r *Release) SetExpirationTime(expireDays time.Duration) {
 	r.Status.ExpirationTime = &metav1.Time{Time: creationTime.Add(time.Hour * 24 * expireDays)}
 }
 
+// SetExpirationTimeWithDeletion sets the time when this release is deleted, which is the given duration after its
+// creation.
+func (r *Release) SetExpirationTimeWithDeletion(expiration time.Duration) {
+	r.Status.DeleteOnExpiration = true
+	r.Status.ExpirationTime = &metav1.Time{Time: r.CreationTimestamp.Add(expiration)}
+}
+
 // getPhaseReason returns the current reason for the given ConditionType or empty string if no condition is found.
 func (r *Release) getPhaseReason(conditionType conditions.ConditionType) string {
 	var reason string
@@ -822,6 +974,18 @@ func (r *Release) isPhaseSkipped(conditionType conditions.ConditionType) bool {
 	return condition != nil && condition.Status == metav1.ConditionTrue && condition.Reason == SkippedReason.String()
 }
 
+// setCondition sets the condition of the given type in the Release status, recording the generation of the Release it
+// was observed at so stale conditions can be detected.
+func (r *Release) setCondition(conditionType conditions.ConditionType, status metav1.ConditionStatus, reason conditions.ConditionReason, message string) {
+	meta.SetStatusCondition(&r.Status.Conditions, metav1.Condition{
+		Type:               conditionType.String(),
+		Status:             status,
+		Reason:             reason.String(),
+		Message:            message,
+		ObservedGeneration: r.Generation,
+	})
+}
+
 // +kubebuilder:object:root=true
 
 // ReleaseList contains a list of Release
@@ -30,6 +30,46 @@ import (
 
 var _ = Describe("Release type", func() {
 
+	When("GetProcessingStartTime method is called", func() {
+		var release *Release
+
+		BeforeEach(func() {
+			release = &Release{}
+		})
+
+		It("should return the start time if the Release was not retried", func() {
+			release.MarkReleasing("")
+			Expect(release.GetProcessingStartTime()).To(Equal(release.Status.StartTime))
+		})
+
+		It("should return the last retry time if the Release was retried", func() {
+			release.Status.StartTime = &metav1.Time{Time: time.Now().Add(-time.Hour)}
+			release.Status.LastRetryTime = &metav1.Time{Time: time.Now()}
+			Expect(release.GetProcessingStartTime()).To(Equal(release.Status.LastRetryTime))
+		})
+
+		It("should return nil if the Release has not started", func() {
+			Expect(release.GetProcessingStartTime()).To(BeNil())
+		})
+	})
+
+	When("GetValidationMessage method is called", func() {
+		var release *Release
+
+		BeforeEach(func() {
+			release = &Release{}
+		})
+
+		It("should return the message of the validated condition", func() {
+			release.MarkValidationFailed("invalid release")
+			Expect(release.GetValidationMessage()).To(Equal("invalid release"))
+		})
+
+		It("should return an empty string when the validated condition is missing", func() {
+			Expect(release.GetValidationMessage()).To(BeEmpty())
+		})
+	})
+
 	When("HasFinalPipelineProcessingFinished method is called", func() {
 		var release *Release
 
@@ -202,6 +242,43 @@ var _ = Describe("Release type", func() {
 		})
 	})
 
+	When("HasExpired method is called", func() {
+		var release *Release
+
+		BeforeEach(func() {
+			release = &Release{
+				Status: ReleaseStatus{
+					DeleteOnExpiration: true,
+					ExpirationTime:     &metav1.Time{Time: time.Now().Add(-time.Minute)},
+				},
+			}
+		})
+
+		It("should return true when the ExpirationTime has been reached", func() {
+			Expect(release.HasExpired()).To(BeTrue())
+		})
+
+		It("should return true when the ExpirationTime is the current time", func() {
+			release.Status.ExpirationTime = &metav1.Time{Time: time.Now()}
+			Expect(release.HasExpired()).To(BeTrue())
+		})
+
+		It("should return false when the ExpirationTime has not been reached", func() {
+			release.Status.ExpirationTime = &metav1.Time{Time: time.Now().Add(time.Minute)}
+			Expect(release.HasExpired()).To(BeFalse())
+		})
+
+		It("should return false when the Release is not set to be deleted on expiration", func() {
+			release.Status.DeleteOnExpiration = false
+			Expect(release.HasExpired()).To(BeFalse())
+		})
+
+		It("should return false when the Release has no ExpirationTime", func() {
+			release.Status.ExpirationTime = nil
+			Expect(release.HasExpired()).To(BeFalse())
+		})
+	})
+
 	When("IsAttributed method is called", func() {
 		var release *Release
 
@@ -668,6 +745,28 @@ var _ = Describe("Release type", func() {
 		})
 	})
 
+	When("IsQueued method is called", func() {
+		var release *Release
+
+		BeforeEach(func() {
+			release = &Release{}
+		})
+
+		It("should return true when the queued condition status is True", func() {
+			conditions.SetCondition(&release.Status.Conditions, queuedConditionType, metav1.ConditionTrue, QueuedReason)
+			Expect(release.IsQueued()).To(BeTrue())
+		})
+
+		It("should return false when the queued condition status is False", func() {
+			conditions.SetCondition(&release.Status.Conditions, queuedConditionType, metav1.ConditionFalse, ProgressingReason)
+			Expect(release.IsQueued()).To(BeFalse())
+		})
+
+		It("should return false when the queued condition is missing", func() {
+			Expect(release.IsQueued()).To(BeFalse())
+		})
+	})
+
 	When("IsReleased method is called", func() {
 		var release *Release
 
@@ -727,6 +826,68 @@ var _ = Describe("Release type", func() {
 		})
 	})
 
+	When("IsReleasePlanAdmissionMissing method is called", func() {
+		var release *Release
+
+		BeforeEach(func() {
+			release = &Release{}
+		})
+
+		It("should return true when the validated condition status is False and the reason is MissingReleasePlanAdmission", func() {
+			conditions.SetCondition(&release.Status.Conditions, validatedConditionType, metav1.ConditionFalse, MissingReleasePlanAdmissionReason)
+			Expect(release.IsReleasePlanAdmissionMissing()).To(BeTrue())
+		})
+
+		It("should return false when the validated condition status is False and the reason is not MissingReleasePlanAdmission", func() {
+			conditions.SetCondition(&release.Status.Conditions, validatedConditionType, metav1.ConditionFalse, FailedReason)
+			Expect(release.IsReleasePlanAdmissionMissing()).To(BeFalse())
+		})
+
+		It("should return false when the validated condition is missing", func() {
+			Expect(release.IsReleasePlanAdmissionMissing()).To(BeFalse())
+		})
+	})
+
+	When("IsRetriable method is called", func() {
+		var release *Release
+
+		BeforeEach(func() {
+			release = &Release{}
+			release.MarkReleasing("")
+			release.MarkManagedPipelineProcessing()
+		})
+
+		It("should return true if the Release failed on the managed pipeline", func() {
+			release.MarkManagedPipelineProcessingFailed("")
+			release.MarkReleaseFailed("")
+			Expect(release.IsRetriable()).To(BeTrue())
+		})
+
+		It("should return true if the Release timed out on the managed pipeline", func() {
+			release.MarkManagedPipelineProcessingFailed("")
+			release.MarkReleaseFailedWithReason(TimeoutReason, "")
+			Expect(release.IsRetriable()).To(BeTrue())
+		})
+
+		It("should return false if the Release succeeded", func() {
+			release.MarkManagedPipelineProcessed()
+			release.MarkReleased()
+			Expect(release.IsRetriable()).To(BeFalse())
+		})
+
+		It("should return false if the managed pipeline is still running", func() {
+			Expect(release.IsRetriable()).To(BeFalse())
+		})
+
+		It("should return false if the Release failed after the managed pipeline succeeded", func() {
+			release.MarkManagedPipelineProcessed()
+			release.MarkFinalPipelineProcessing()
+			release.MarkFinalPipelineProcessingFailed("")
+			release.MarkReleaseFailed("")
+			Expect(release.IsRetriable()).To(BeFalse())
+		})
+	})
+
 	When("IsValid method is called", func() {
 		var release *Release
 
@@ -766,6 +927,16 @@ var _ = Describe("Release type", func() {
 			Expect(release.IsFailed()).To(BeTrue())
 		})
 
+		It("should return true when the released condition status is False with PipelineRunDeleted reason", func() {
+			conditions.SetCondition(&release.Status.Conditions, releasedConditionType, metav1.ConditionFalse, PipelineRunDeletedReason)
+			Expect(release.IsFailed()).To(BeTrue())
+		})
+
+		It("should return true when the released condition status is False with Timeout reason", func() {
+			conditions.SetCondition(&release.Status.Conditions, releasedConditionType, metav1.ConditionFalse, TimeoutReason)
+			Expect(release.IsFailed()).To(BeTrue())
+		})
+
 		It("should return false when the released condition status is True", func() {
 			conditions.SetCondition(&release.Status.Conditions, releasedConditionType, metav1.ConditionTrue, SucceededReason)
 			Expect(release.IsFailed()).To(BeFalse())
@@ -781,6 +952,28 @@ var _ = Describe("Release type", func() {
 		})
 	})
 
+	When("MarkDequeued method is called", func() {
+		var release *Release
+
+		BeforeEach(func() {
+			release = &Release{}
+			release.MarkReleasing("")
+		})
+
+		It("should do nothing if the Release is not queued", func() {
+			release.MarkDequeued()
+			Expect(meta.FindStatusCondition(release.Status.Conditions, queuedConditionType.String())).To(BeNil())
+		})
+
+		It("should clear the queue and mark the Release as no longer queued", func() {
+			release.MarkQueued("default/queue", "")
+			release.MarkDequeued()
+
+			Expect(release.IsQueued()).To(BeFalse())
+			Expect(release.Status.Queue).To(BeEmpty())
+		})
+	})
+
 	When("MarkFinalPipelineProcessed method is called", func() {
 		var release *Release
 
@@ -1593,6 +1786,40 @@ var _ = Describe("Release type", func() {
 		})
 	})
 
+	When("MarkQueued method is called", func() {
+		var release *Release
+
+		BeforeEach(func() {
+			release = &Release{}
+		})
+
+		It("should do nothing if the Release is not releasing", func() {
+			release.MarkQueued("default/queue", "")
+			Expect(release.IsQueued()).To(BeFalse())
+			Expect(release.Status.Queue).To(BeEmpty())
+		})
+
+		It("should do nothing if the Release finished", func() {
+			release.MarkReleasing("")
+			release.MarkReleased()
+			release.MarkQueued("default/queue", "")
+			Expect(release.IsQueued()).To(BeFalse())
+		})
+
+		It("should register the queue and mark the Release as queued", func() {
+			release.MarkReleasing("")
+			release.MarkQueued("default/queue", "waiting")
+
+			Expect(release.IsQueued()).To(BeTrue())
+			Expect(release.Status.Queue).To(Equal("default/queue"))
+
+			condition := meta.FindStatusCondition(release.Status.Conditions, queuedConditionType.String())
+			Expect(condition).NotTo(BeNil())
+			Expect(condition.Reason).To(Equal(QueuedReason.String()))
+			Expect(condition.Message).To(Equal("waiting"))
+		})
+	})
+
 	When("MarkReleased method is called", func() {
 		var release *Release
 
@@ -1722,6 +1949,163 @@ var _ = Describe("Release type", func() {
 		})
 	})
 
+	When("a condition is set", func() {
+		It("should record the generation of the Release", func() {
+			release := &Release{}
+			release.Generation = 3
+			release.MarkReleasing("")
+
+			condition := meta.FindStatusCondition(release.Status.Conditions, releasedConditionType.String())
+			Expect(condition).NotTo(BeNil())
+			Expect(condition.ObservedGeneration).To(Equal(int64(3)))
+
+			release.Generation = 4
+			release.MarkReleased()
+
+			condition = meta.FindStatusCondition(release.Status.Conditions, releasedConditionType.String())
+			Expect(condition.ObservedGeneration).To(Equal(int64(4)))
+		})
+	})
+
+	When("MarkReleaseFailedWithReason method is called", func() {
+		var release *Release
+
+		BeforeEach(func() {
+			release = &Release{}
+		})
+
+		It("should do nothing if the Release has not started", func() {
+			release.MarkReleaseFailedWithReason(PipelineRunDeletedReason, "")
+			Expect(release.Status.CompletionTime).To(BeNil())
+		})
+
+		It("should register the condition with the given reason", func() {
+			release.MarkReleasing("")
+			release.MarkReleaseFailedWithReason(PipelineRunDeletedReason, "foo")
+
+			condition := meta.FindStatusCondition(release.Status.Conditions, releasedConditionType.String())
+			Expect(condition).NotTo(BeNil())
+			Expect(*condition).To(MatchFields(IgnoreExtras, Fields{
+				"Message": Equal("foo"),
+				"Reason":  Equal(PipelineRunDeletedReason.String()),
+				"Status":  Equal(metav1.ConditionFalse),
+			}))
+		})
+	})
+
+	When("MarkReleasePlanAdmissionMissing method is called", func() {
+		var release *Release
+
+		BeforeEach(func() {
+			release = &Release{}
+		})
+
+		It("should do nothing if the Release is already valid", func() {
+			release.MarkValidated()
+			release.MarkReleasePlanAdmissionMissing("")
+			Expect(release.IsValid()).To(BeTrue())
+		})
+
+		It("should mark the Release validation as waiting for the ReleasePlanAdmission", func() {
+			release.MarkReleasePlanAdmissionMissing("not found")
+			Expect(release.IsReleasePlanAdmissionMissing()).To(BeTrue())
+			Expect(release.Status.Validation.Time).To(BeNil())
+
+			condition := meta.FindStatusCondition(release.Status.Conditions, validatedConditionType.String())
+			Expect(condition).NotTo(BeNil())
+			Expect(condition.Message).To(Equal("not found"))
+		})
+
+		It("should allow the Release to be validated afterwards", func() {
+			release.MarkReleasePlanAdmissionMissing("")
+			release.MarkValidated()
+			Expect(release.IsValid()).To(BeTrue())
+		})
+	})
+
+	When("MarkRetried method is called", func() {
+		var release *Release
+
+		BeforeEach(func() {
+			release = &Release{}
+			release.MarkReleasing("")
+			release.MarkManagedPipelineProcessing()
+			release.Status.ManagedProcessing.Attempt = 2
+			release.Status.ManagedProcessing.PipelineRun = "foo/bar"
+			release.Status.ManagedProcessing.Retries = 1
+			release.MarkManagedPipelineProcessingFailed("")
+			release.MarkFinalPipelineProcessingSkipped()
+			release.MarkReleaseFailed("")
+		})
+
+		It("should do nothing if the Release has not failed", func() {
+			release = &Release{}
+			release.MarkReleasing("")
+			release.MarkRetried()
+			Expect(release.Status.Attempts).To(BeZero())
+		})
+
+		It("should increase the number of attempts", func() {
+			release.MarkRetried()
+			Expect(release.Status.Attempts).To(Equal(1))
+			release.MarkReleaseFailed("")
+			release.MarkRetried()
+			Expect(release.Status.Attempts).To(Equal(2))
+		})
+
+		It("should reset the managed and final processing", func() {
+			release.MarkRetried()
+			Expect(release.HasManagedPipelineProcessingFinished()).To(BeFalse())
+			Expect(release.IsManagedPipelineProcessing()).To(BeFalse())
+			Expect(release.HasFinalPipelineProcessingFinished()).To(BeFalse())
+			Expect(release.Status.ManagedProcessing.PipelineRun).To(BeEmpty())
+			Expect(release.Status.ManagedProcessing.Retries).To(BeZero())
+			Expect(release.Status.ManagedProcessing.Attempt).To(Equal(2))
+		})
+
+		It("should give each attempt its own automatic retries while counting all the PipelineRuns", func() {
+			release.Status.ManagedProcessing.Retries = 2
+			release.MarkRetried()
+			Expect(release.Status.Attempts).To(Equal(1))
+			Expect(release.Status.ManagedProcessing.Retries).To(BeZero())
+			Expect(release.Status.ManagedProcessing.Attempt).To(Equal(2))
+
+			// The new attempt is retried automatically and fails again
+			release.MarkManagedPipelineProcessing()
+			release.Status.ManagedProcessing.Attempt = 4
+			release.Status.ManagedProcessing.Retries = 1
+			release.MarkManagedPipelineProcessingFailed("")
+			release.MarkReleaseFailed("")
+
+			release.MarkRetried()
+			Expect(release.Status.Attempts).To(Equal(2))
+			Expect(release.Status.ManagedProcessing.Retries).To(BeZero())
+			Expect(release.Status.ManagedProcessing.Attempt).To(Equal(4))
+		})
+
+		It("should mark the Release as releasing again", func() {
+			release.MarkRetried()
+			Expect(release.IsReleasing()).To(BeTrue())
+			Expect(release.IsFailed()).To(BeFalse())
+			Expect(release.Status.CompletionTime).To(BeNil())
+		})
+
+		It("should restart the processing time of a Release that timed out", func() {
+			release = &Release{}
+			release.MarkReleasing("")
+			release.Status.StartTime = &metav1.Time{Time: time.Now().Add(-time.Hour)}
+			release.MarkManagedPipelineProcessing()
+			release.MarkManagedPipelineProcessingFailed("")
+			release.MarkReleaseFailedWithReason(TimeoutReason, "")
+
+			release.MarkRetried()
+			Expect(release.IsReleasing()).To(BeTrue())
+			Expect(release.Status.LastRetryTime).NotTo(BeNil())
+			Expect(release.GetProcessingStartTime().Time).To(BeTemporally("~", time.Now(), time.Minute))
+			Expect(release.Status.StartTime.Time).To(BeTemporally("~", time.Now().Add(-time.Hour), time.Minute))
+		})
+	})
+
 	When("MarkValidated method is called", func() {
 		var release *Release
 
@@ -1914,4 +2298,59 @@ var _ = Describe("Release type", func() {
 			Expect(release.Status.ExpirationTime).To(Equal(expectedExpirationTime))
 		})
 	})
+
+	When("SetExpirationTimeWithDeletion method is called", func() {
+		var release *Release
+
+		BeforeEach(func() {
+			release = &Release{
+				ObjectMeta: metav1.ObjectMeta{
+					CreationTimestamp: metav1.Now(),
+				},
+			}
+		})
+
+		It("should set the ExpirationTime using the creation time and the given duration", func() {
+			release.SetExpirationTimeWithDeletion(time.Hour)
+			Expect(release.Status.ExpirationTime.Time).To(Equal(release.CreationTimestamp.Add(time.Hour)))
+		})
+
+		It("should set the Release to be deleted on expiration", func() {
+			release.SetExpirationTimeWithDeletion(time.Hour)
+			Expect(release.Status.DeleteOnExpiration).To(BeTrue())
+		})
+	})
+
+	When("the printer columns are resolved", func() {
+		var release *Release
+
+		BeforeEach(func() {
+			release = &Release{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "release",
+					Namespace: "default",
+				},
+				Spec: ReleaseSpec{
+					Snapshot:    "snapshot",
+					ReleasePlan: "releaseplan",
+				},
+			}
+		})
+
+		It("should print the Snapshot column", func() {
+			Expect(getPrinterColumnValue(release, ".spec.snapshot")).To(Equal("snapshot"))
+		})
+
+		It("should print the ReleasePlan column", func() {
+			Expect(getPrinterColumnValue(release, ".spec.releasePlan")).To(Equal("releaseplan"))
+		})
+
+		It("should print the Release status column", func() {
+			path := `.status.conditions[?(@.type=="Released")].reason`
+			Expect(getPrinterColumnValue(release, path)).To(BeEmpty())
+
+			release.MarkReleasing("")
+			Expect(getPrinterColumnValue(release, path)).To(Equal("Progressing"))
+		})
+	})
 })
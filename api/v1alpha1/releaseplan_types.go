@@ -52,6 +52,16 @@ type ReleasePlanSpec struct {
 	// +optional
 	FinalPipeline *tektonutils.ParameterizedPipeline `json:"finalPipeline,omitempty"`
 
+	// ExpirationTime is the time after their creation when the Releases created for this ReleasePlan are deleted
+	// once they have finished. If not set, the Releases are not deleted automatically
+	// +optional
+	ExpirationTime *metav1.Duration `json:"expirationTime,omitempty"`
+
+	// ExpireExistingReleases indicates whether the ExpirationTime also applies to the finished Releases created
+	// before it was set or changed
+	// +optional
+	ExpireExistingReleases bool `json:"expireExistingReleases,omitempty"`
+
 	// ReleaseGracePeriodDays is the number of days a Release should be kept
 	// This value is used to define the Release ExpirationTime
 	// +kubebuilder:default:=7
@@ -93,6 +103,7 @@ type ReleasePlanStatus struct {
 // +kubebuilder:subresource:status
 // +kubebuilder:printcolumn:name="Application",type=string,JSONPath=`.spec.application`
 // +kubebuilder:printcolumn:name="Target",type=string,JSONPath=`.spec.target`
+// +kubebuilder:printcolumn:name="Auto-Release",type=string,JSONPath=`.metadata.labels.release\.appstudio\.openshift\.io/auto-release`
 
 // ReleasePlan is the Schema for the ReleasePlans API.
 type ReleasePlan struct {
@@ -126,4 +126,37 @@ var _ = Describe("ReleasePlan type", func() {
 			Expect(condition.Status).To(Equal(metav1.ConditionUnknown))
 		})
 	})
+
+	When("the printer columns are resolved", func() {
+		var releasePlan *ReleasePlan
+
+		BeforeEach(func() {
+			releasePlan = &ReleasePlan{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "rp",
+					Namespace: "default",
+					Labels: map[string]string{
+						metadata.AutoReleaseLabel: "true",
+					},
+				},
+				Spec: ReleasePlanSpec{
+					Application: "app",
+					Target:      "managed",
+				},
+			}
+		})
+
+		It("should print the Application column", func() {
+			Expect(getPrinterColumnValue(releasePlan, ".spec.application")).To(Equal("app"))
+		})
+
+		It("should print the Target column", func() {
+			Expect(getPrinterColumnValue(releasePlan, ".spec.target")).To(Equal("managed"))
+		})
+
+		It("should print the Auto-Release column", func() {
+			path := `.metadata.labels.release\.appstudio\.openshift\.io/auto-release`
+			Expect(getPrinterColumnValue(releasePlan, path)).To(Equal("true"))
+		})
+	})
 })
@@ -43,6 +43,11 @@ type ReleasePlanAdmissionSpec struct {
 	// +optional
 	Data *runtime.RawExtension `json:"data,omitempty"`
 
+	// DeterministicPipelineRunName indicates whether the managed PipelineRun should be named after the Release
+	// instead of using a generated name
+	// +optional
+	DeterministicPipelineRunName bool `json:"deterministicPipelineRunName,omitempty"`
+
 	// Environment defines which Environment will be used to release the Application
 	// +kubebuilder:validation:Pattern=^[a-z0-9]([-a-z0-9]*[a-z0-9])?$
 	// +optional
@@ -57,10 +62,32 @@ type ReleasePlanAdmissionSpec struct {
 	// +optional
 	Pipeline *tektonutils.Pipeline `json:"pipeline,omitempty"`
 
+	// PipelineConcurrencyLimit is the maximum number of managed PipelineRuns created for this ReleasePlanAdmission that
+	// can run at the same time. Releases exceeding it are queued. If not set, the number is not limited
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	PipelineConcurrencyLimit int `json:"pipelineConcurrencyLimit,omitempty"`
+
 	// Policy to validate before releasing an artifact
 	// +kubebuilder:validation:Pattern=^[a-z0-9]([-a-z0-9]*[a-z0-9])?$
 	// +required
 	Policy string `json:"policy"`
+
+	// ProcessingDeadline is the maximum time the processing of a Release can take before its PipelineRun is
+	// cancelled and the Release is marked as failed. If set, it overrides the deadline configured in the operator
+	// +optional
+	ProcessingDeadline *metav1.Duration `json:"processingDeadline,omitempty"`
+
+	// ReleaseGracePeriodDays is the number of days the managed PipelineRuns of a finished Release are kept
+	// before being deleted. If not set, the PipelineRuns are not deleted
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	ReleaseGracePeriodDays int `json:"releaseGracePeriodDays,omitempty"`
+
+	// Retries is the number of times a failed managed PipelineRun is recreated before the Release is marked as failed
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	Retries int `json:"retries,omitempty"`
 }
 
 // MatchedReleasePlan defines the relevant information for a matched ReleasePlan.
@@ -90,8 +117,10 @@ type ReleasePlanAdmissionStatus struct {
 // +kubebuilder:object:root=true
 // +kubebuilder:resource:shortName=rpa
 // +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Applications",type=string,JSONPath=`.spec.applications`
 // +kubebuilder:printcolumn:name="Environment",type=string,JSONPath=`.spec.environment`
 // +kubebuilder:printcolumn:name="Origin",type=string,JSONPath=`.spec.origin`
+// +kubebuilder:printcolumn:name="Matched",type=string,JSONPath=`.status.conditions[?(@.type=="Matched")].status`
 
 // ReleasePlanAdmission is the Schema for the ReleasePlanAdmissions API.
 type ReleasePlanAdmission struct {
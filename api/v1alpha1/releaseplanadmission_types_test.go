@@ -126,4 +126,42 @@ var _ = Describe("ReleasePlanAdmission type", func() {
 			}))
 		})
 	})
+
+	When("the printer columns are resolved", func() {
+		var releasePlanAdmission *ReleasePlanAdmission
+
+		BeforeEach(func() {
+			releasePlanAdmission = &ReleasePlanAdmission{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "rpa",
+					Namespace: "default",
+				},
+				Spec: ReleasePlanAdmissionSpec{
+					Applications: []string{"app"},
+					Environment:  "production",
+					Origin:       "dev",
+				},
+			}
+		})
+
+		It("should print the Applications column", func() {
+			Expect(getPrinterColumnValue(releasePlanAdmission, ".spec.applications")).To(Equal(`["app"]`))
+		})
+
+		It("should print the Environment column", func() {
+			Expect(getPrinterColumnValue(releasePlanAdmission, ".spec.environment")).To(Equal("production"))
+		})
+
+		It("should print the Origin column", func() {
+			Expect(getPrinterColumnValue(releasePlanAdmission, ".spec.origin")).To(Equal("dev"))
+		})
+
+		It("should print the Matched column", func() {
+			path := `.status.conditions[?(@.type=="Matched")].status`
+			Expect(getPrinterColumnValue(releasePlanAdmission, path)).To(BeEmpty())
+
+			releasePlanAdmission.ClearMatchingInfo()
+			Expect(getPrinterColumnValue(releasePlanAdmission, path)).To(Equal("False"))
+		})
+	})
 })
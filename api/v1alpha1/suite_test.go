@@ -17,11 +17,14 @@ limitations under the License.
 package v1alpha1
 
 import (
+	"bytes"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	"path/filepath"
 	"testing"
 
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/util/jsonpath"
 	"sigs.k8s.io/controller-runtime/pkg/envtest"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
 	"sigs.k8s.io/controller-runtime/pkg/log/zap"
@@ -56,3 +59,20 @@ var _ = AfterSuite(func() {
 	err := testEnv.Stop()
 	Expect(err).NotTo(HaveOccurred())
 })
+
+// getPrinterColumnValue returns the value kubectl would print for a column defined with the given JSONPath.
+func getPrinterColumnValue(object runtime.Object, path string) string {
+	unstructuredObject, err := runtime.DefaultUnstructuredConverter.ToUnstructured(object)
+	Expect(err).NotTo(HaveOccurred())
+
+	parser := jsonpath.New("printer-column").AllowMissingKeys(true)
+	Expect(parser.Parse("{" + path + "}")).To(Succeed())
+
+	// kubectl prints an empty cell when the path can't be resolved, e.g. when filtering a missing list
+	buffer := &bytes.Buffer{}
+	if err := parser.Execute(buffer, unstructuredObject); err != nil {
+		return ""
+	}
+
+	return buffer.String()
+}
@@ -169,5 +169,6 @@ func (w *Webhook) sanitizeLabelValue(username string) string {
 		author = string(author)[0:metadata.MaxLabelLength]
 	}
 
-	return author
+	// Replace any other disallowed character and make sure the truncated value doesn't end with a separator
+	return metadata.SanitizeLabelValue(author)
 }
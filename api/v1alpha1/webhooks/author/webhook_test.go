@@ -18,6 +18,7 @@ package author
 import (
 	"encoding/json"
 	"net/http"
+	"strings"
 
 	"github.com/konflux-ci/release-service/api/v1alpha1"
 
@@ -531,5 +532,15 @@ var _ = Describe("Author webhook", Ordered, func() {
 			str := webhook.sanitizeLabelValue("user@konflux-ci.dev")
 			Expect(str).To(Equal("user.konflux-ci.dev"))
 		})
+
+		It("should replace other characters not allowed in label values", func() {
+			str := webhook.sanitizeLabelValue("first last+test")
+			Expect(str).To(Equal("first-last-test"))
+		})
+
+		It("should not end with a separator after trimming long author values", func() {
+			str := webhook.sanitizeLabelValue(strings.Repeat("a", 62) + ":b")
+			Expect(str).To(Equal(strings.Repeat("a", 62)))
+		})
 	})
 })
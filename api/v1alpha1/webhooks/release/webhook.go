@@ -20,6 +20,7 @@ import (
 	"context"
 	"fmt"
 	"reflect"
+	"strings"
 
 	"github.com/konflux-ci/release-service/loader"
 
@@ -91,8 +92,9 @@ func (w *Webhook) ValidateUpdate(ctx context.Context, oldObj, newObj runtime.Obj
 	oldRelease := oldObj.(*v1alpha1.Release)
 	newRelease := newObj.(*v1alpha1.Release)
 
-	if !reflect.DeepEqual(newRelease.Spec, oldRelease.Spec) {
-		return nil, fmt.Errorf("release resources spec cannot be updated")
+	if changedFields := getChangedSpecFields(oldRelease.Spec, newRelease.Spec); len(changedFields) > 0 {
+		return nil, fmt.Errorf("release resources spec cannot be updated, found changes in: %s",
+			strings.Join(changedFields, ", "))
 	}
 
 	return nil, nil
@@ -102,3 +104,20 @@ func (w *Webhook) ValidateUpdate(ctx context.Context, oldObj, newObj runtime.Obj
 func (w *Webhook) ValidateDelete(ctx context.Context, obj runtime.Object) (warnings admission.Warnings, err error) {
 	return nil, nil
 }
+
+// getChangedSpecFields returns the JSON paths of the fields that differ between the two given Release specs.
+func getChangedSpecFields(oldSpec, newSpec v1alpha1.ReleaseSpec) []string {
+	var changedFields []string
+
+	oldValue, newValue := reflect.ValueOf(oldSpec), reflect.ValueOf(newSpec)
+	for i := 0; i < oldValue.NumField(); i++ {
+		if reflect.DeepEqual(oldValue.Field(i).Interface(), newValue.Field(i).Interface()) {
+			continue
+		}
+
+		name := strings.Split(oldValue.Type().Field(i).Tag.Get("json"), ",")[0]
+		changedFields = append(changedFields, "spec."+name)
+	}
+
+	return changedFields
+}
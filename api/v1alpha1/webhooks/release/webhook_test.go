@@ -77,6 +77,10 @@ var _ = Describe("Release validation webhook", func() {
 	})
 
 	When("When ValidateUpdate is called", func() {
+		BeforeEach(func() {
+			createResources()
+		})
+
 		It("should error out when updating the resource", func() {
 			updatedRelease := release.DeepCopy()
 			updatedRelease.Spec.Snapshot = "another-snapshot"
@@ -86,6 +90,35 @@ var _ = Describe("Release validation webhook", func() {
 			Expect(err.Error()).Should(ContainSubstring("release resources spec cannot be updated"))
 		})
 
+		It("should list the changed fields in the error", func() {
+			updatedRelease := release.DeepCopy()
+			updatedRelease.Spec.ReleasePlan = "another-releaseplan"
+			updatedRelease.Spec.Snapshot = "another-snapshot"
+
+			_, err := webhook.ValidateUpdate(ctx, release, updatedRelease)
+			Expect(err).Should(HaveOccurred())
+			Expect(err.Error()).Should(HaveSuffix("found changes in: spec.snapshot, spec.releasePlan"))
+		})
+
+		It("should error out when updating the resource once the Release has started", func() {
+			release.MarkReleasing("")
+			updatedRelease := release.DeepCopy()
+			updatedRelease.Spec.GracePeriodDays = 1
+
+			_, err := webhook.ValidateUpdate(ctx, release, updatedRelease)
+			Expect(err).Should(HaveOccurred())
+			Expect(err.Error()).Should(ContainSubstring("spec.gracePeriodDays"))
+		})
+
+		It("should not error out when updating the resource status", func() {
+			updatedRelease := release.DeepCopy()
+			updatedRelease.MarkReleasing("")
+			updatedRelease.MarkReleased()
+
+			_, err := webhook.ValidateUpdate(ctx, release, updatedRelease)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
 		It("should not error out when updating the resource metadata", func() {
 			ctx := context.Background()
 
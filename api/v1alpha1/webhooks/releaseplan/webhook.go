@@ -67,12 +67,20 @@ func (w *Webhook) Register(mgr ctrl.Manager, log *logr.Logger) error {
 
 // ValidateCreate implements webhook.Validator so a webhook will be registered for the type.
 func (w *Webhook) ValidateCreate(ctx context.Context, obj runtime.Object) (warnings admission.Warnings, err error) {
-	return w.validateAutoReleaseLabel(obj)
+	if warnings, err = w.validateAutoReleaseLabel(obj); err != nil {
+		return warnings, err
+	}
+
+	return w.validatePipelineRefs(obj)
 }
 
 // ValidateUpdate implements webhook.Validator so a webhook will be registered for the type.
 func (w *Webhook) ValidateUpdate(ctx context.Context, oldObj, newObj runtime.Object) (warnings admission.Warnings, err error) {
-	return w.validateAutoReleaseLabel(newObj)
+	if warnings, err = w.validateAutoReleaseLabel(newObj); err != nil {
+		return warnings, err
+	}
+
+	return w.validatePipelineRefs(newObj)
 }
 
 // ValidateDelete implements webhook.Validator so a webhook will be registered for the type.
@@ -91,3 +99,29 @@ func (w *Webhook) validateAutoReleaseLabel(obj runtime.Object) (warnings admissi
 	}
 	return nil, nil
 }
+
+// validatePipelineRefs throws an error if any of the Pipelines in the ReleasePlan has an ambiguous PipelineRef or
+// params.
+func (w *Webhook) validatePipelineRefs(obj runtime.Object) (warnings admission.Warnings, err error) {
+	releasePlan := obj.(*v1alpha1.ReleasePlan)
+
+	if releasePlan.Spec.TenantPipeline != nil {
+		if err := releasePlan.Spec.TenantPipeline.PipelineRef.Validate(); err != nil {
+			return nil, fmt.Errorf("invalid tenantPipeline: %w", err)
+		}
+		if err := releasePlan.Spec.TenantPipeline.ValidateParams(); err != nil {
+			return nil, fmt.Errorf("invalid tenantPipeline: %w", err)
+		}
+	}
+
+	if releasePlan.Spec.FinalPipeline != nil {
+		if err := releasePlan.Spec.FinalPipeline.PipelineRef.Validate(); err != nil {
+			return nil, fmt.Errorf("invalid finalPipeline: %w", err)
+		}
+		if err := releasePlan.Spec.FinalPipeline.ValidateParams(); err != nil {
+			return nil, fmt.Errorf("invalid finalPipeline: %w", err)
+		}
+	}
+
+	return nil, nil
+}
@@ -17,6 +17,7 @@ package releaseplan
 
 import (
 	"github.com/konflux-ci/release-service/api/v1alpha1"
+	tektonutils "github.com/konflux-ci/release-service/tekton/utils"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 
@@ -125,6 +126,41 @@ var _ = Describe("ReleasePlan webhook", func() {
 		})
 	})
 
+	When("a ReleasePlan is created with an ambiguous tenant PipelineRef", func() {
+		It("should get rejected", func() {
+			releasePlan.Spec.TenantPipeline = &tektonutils.ParameterizedPipeline{
+				Pipeline: tektonutils.Pipeline{
+					PipelineRef: tektonutils.PipelineRef{
+						Resolver: "git",
+						Params: []tektonutils.Param{
+							{Name: "url", Value: "https://github.com/org/repo.git"},
+							{Name: "revision", Value: "main"},
+						},
+					},
+				},
+			}
+			err := k8sClient.Create(ctx, releasePlan)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("invalid tenantPipeline"))
+		})
+	})
+
+	When("a ReleasePlan is created with a tenant param defining both value and objectValues", func() {
+		It("should get rejected", func() {
+			releasePlan.Spec.TenantPipeline = &tektonutils.ParameterizedPipeline{
+				Pipeline: tektonutils.Pipeline{
+					PipelineRef: *tektonutils.NewGitPipelineRef("https://github.com/org/repo.git", "main", "pipeline.yaml"),
+				},
+				Params: []tektonutils.Param{
+					{Name: "param", Value: "value", ObjectValues: map[string]string{"key": "value"}},
+				},
+			}
+			err := k8sClient.Create(ctx, releasePlan)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("can't define both value and objectValues"))
+		})
+	})
+
 	When("ValidateDelete method is called", func() {
 		It("should return nil", func() {
 			releasePlan := &v1alpha1.ReleasePlan{}
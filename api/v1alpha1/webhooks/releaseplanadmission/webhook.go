@@ -69,12 +69,20 @@ func (w *Webhook) Register(mgr ctrl.Manager, log *logr.Logger) error {
 
 // ValidateCreate implements webhook.Validator so a webhook will be registered for the type.
 func (w *Webhook) ValidateCreate(ctx context.Context, obj runtime.Object) (warnings admission.Warnings, err error) {
-	return w.validateBlockReleasesLabel(obj)
+	if warnings, err = w.validateBlockReleasesLabel(obj); err != nil {
+		return warnings, err
+	}
+
+	return w.validatePipelineRefs(obj)
 }
 
 // ValidateUpdate implements webhook.Validator so a webhook will be registered for the type.
 func (w *Webhook) ValidateUpdate(ctx context.Context, oldObj, newObj runtime.Object) (warnings admission.Warnings, err error) {
-	return w.validateBlockReleasesLabel(newObj)
+	if warnings, err = w.validateBlockReleasesLabel(newObj); err != nil {
+		return warnings, err
+	}
+
+	return w.validatePipelineRefs(newObj)
 }
 
 // ValidateDelete implements webhook.Validator so a webhook will be registered for the type.
@@ -93,3 +101,16 @@ func (w *Webhook) validateBlockReleasesLabel(obj runtime.Object) (warnings admis
 	}
 	return nil, nil
 }
+
+// validatePipelineRefs throws an error if any of the Pipelines in the ReleasePlanAdmission has an ambiguous PipelineRef.
+func (w *Webhook) validatePipelineRefs(obj runtime.Object) (warnings admission.Warnings, err error) {
+	releasePlanAdmission := obj.(*v1alpha1.ReleasePlanAdmission)
+
+	if releasePlanAdmission.Spec.Pipeline != nil {
+		if err := releasePlanAdmission.Spec.Pipeline.PipelineRef.Validate(); err != nil {
+			return nil, fmt.Errorf("invalid pipeline: %w", err)
+		}
+	}
+
+	return nil, nil
+}
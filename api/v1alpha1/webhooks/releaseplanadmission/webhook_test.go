@@ -137,6 +137,18 @@ var _ = Describe("ReleasePlanAdmission webhook", func() {
 		})
 	})
 
+	When("a ReleasePlanAdmission is created with an ambiguous PipelineRef", func() {
+		It("should get rejected", func() {
+			releasePlanAdmission.Spec.Pipeline.PipelineRef.Params = append(
+				releasePlanAdmission.Spec.Pipeline.PipelineRef.Params,
+				tektonutils.Param{Name: "url", Value: "https://github.com/org/repo.git"},
+			)
+			err := k8sClient.Create(ctx, releasePlanAdmission)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("invalid pipeline"))
+		})
+	})
+
 	When("ValidateDelete method is called", func() {
 		It("should return nil", func() {
 			releasePlanAdmission := &v1alpha1.ReleasePlanAdmission{}
@@ -22,6 +22,7 @@ package v1alpha1
 
 import (
 	"github.com/konflux-ci/release-service/tekton/utils"
+	pipelinev1 "github.com/tektoncd/pipeline/pkg/apis/pipeline/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 )
@@ -357,6 +358,11 @@ func (in *ReleasePlanAdmissionSpec) DeepCopyInto(out *ReleasePlanAdmissionSpec)
 		*out = new(utils.Pipeline)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.ProcessingDeadline != nil {
+		in, out := &in.ProcessingDeadline, &out.ProcessingDeadline
+		*out = new(v1.Duration)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReleasePlanAdmissionSpec.
@@ -441,6 +447,11 @@ func (in *ReleasePlanSpec) DeepCopyInto(out *ReleasePlanSpec) {
 		*out = new(runtime.RawExtension)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.ExpirationTime != nil {
+		in, out := &in.ExpirationTime, &out.ExpirationTime
+		*out = new(v1.Duration)
+		**out = **in
+	}
 	if in.TenantPipeline != nil {
 		in, out := &in.TenantPipeline, &out.TenantPipeline
 		*out = new(utils.ParameterizedPipeline)
@@ -589,6 +600,13 @@ func (in *ReleaseSpec) DeepCopyInto(out *ReleaseSpec) {
 		*out = new(runtime.RawExtension)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Params != nil {
+		in, out := &in.Params, &out.Params
+		*out = make([]pipelinev1.Param, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReleaseSpec.
@@ -635,6 +653,10 @@ func (in *ReleaseStatus) DeepCopyInto(out *ReleaseStatus) {
 		in, out := &in.StartTime, &out.StartTime
 		*out = (*in).DeepCopy()
 	}
+	if in.LastRetryTime != nil {
+		in, out := &in.LastRetryTime, &out.LastRetryTime
+		*out = (*in).DeepCopy()
+	}
 	if in.ExpirationTime != nil {
 		in, out := &in.ExpirationTime, &out.ExpirationTime
 		*out = (*in).DeepCopy()
@@ -35,14 +35,38 @@ func SetupComponentCache(mgr ctrl.Manager) error {
 		"spec.application", componentIndexFunc)
 }
 
-// SetupReleaseCache adds a new index field to be able to search Releases by ReleasePlan name.
+// SetupReleaseCache adds new index fields to be able to search Releases by ReleasePlan name and by the queue they
+// are waiting in.
 func SetupReleaseCache(mgr ctrl.Manager) error {
 	releaseIndexFunc := func(obj client.Object) []string {
 		return []string{obj.(*v1alpha1.Release).Spec.ReleasePlan}
 	}
 
-	return mgr.GetCache().IndexField(context.Background(), &v1alpha1.Release{},
+	err := mgr.GetCache().IndexField(context.Background(), &v1alpha1.Release{},
 		"spec.releasePlan", releaseIndexFunc)
+	if err != nil {
+		return err
+	}
+
+	queueIndexFunc := func(obj client.Object) []string {
+		if queue := obj.(*v1alpha1.Release).Status.Queue; queue != "" {
+			return []string{queue}
+		}
+		return nil
+	}
+
+	return mgr.GetCache().IndexField(context.Background(), &v1alpha1.Release{},
+		"status.queue", queueIndexFunc)
+}
+
+// SetupReleaseSnapshotCache adds a new index field to be able to search Releases by Snapshot.
+func SetupReleaseSnapshotCache(mgr ctrl.Manager) error {
+	releaseSnapshotIndexFunc := func(obj client.Object) []string {
+		return []string{obj.(*v1alpha1.Release).Spec.Snapshot}
+	}
+
+	return mgr.GetCache().IndexField(context.Background(), &v1alpha1.Release{},
+		"spec.snapshot", releaseSnapshotIndexFunc)
 }
 
 // SetupReleasePlanCache adds a new index field to be able to search ReleasePlans by target.
@@ -21,11 +21,17 @@ import (
 	"github.com/konflux-ci/release-service/controllers/release"
 	"github.com/konflux-ci/release-service/controllers/releaseplan"
 	"github.com/konflux-ci/release-service/controllers/releaseplanadmission"
+	"github.com/konflux-ci/release-service/controllers/snapshot"
+	"github.com/konflux-ci/release-service/tekton/utils"
 )
 
-// EnabledControllers is a slice containing references to all the controllers that have to be registered
-var EnabledControllers = []controller.Controller{
-	&release.Controller{},
-	&releaseplan.Controller{},
-	&releaseplanadmission.Controller{},
+// GetEnabledControllers returns a slice containing references to all the controllers that have to be registered. The
+// given PipelineRunConfig is passed to the controllers creating release PipelineRuns.
+func GetEnabledControllers(pipelineRunConfig utils.PipelineRunConfig) []controller.Controller {
+	return []controller.Controller{
+		&release.Controller{PipelineRunConfig: pipelineRunConfig},
+		&releaseplan.Controller{},
+		&releaseplanadmission.Controller{},
+		&snapshot.Controller{},
+	}
 }
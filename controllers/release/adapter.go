@@ -18,28 +18,32 @@ package release
 
 import (
 	"context"
+	"encoding/json"
 	stderrors "errors"
 	"fmt"
 	"os"
 	"strings"
 	"time"
+	"unicode/utf8"
 
 	"github.com/go-logr/logr"
 	applicationapiv1alpha1 "github.com/konflux-ci/application-api/api/v1alpha1"
-	integrationgitops "github.com/konflux-ci/integration-service/gitops"
 	"github.com/konflux-ci/operator-toolkit/controller"
 	toolkitmetadata "github.com/konflux-ci/operator-toolkit/metadata"
 	"github.com/konflux-ci/release-service/api/v1alpha1"
 	"github.com/konflux-ci/release-service/loader"
 	"github.com/konflux-ci/release-service/metadata"
 	"github.com/konflux-ci/release-service/syncer"
+	"github.com/konflux-ci/release-service/tekton"
 	"github.com/konflux-ci/release-service/tekton/utils"
 	tektonv1 "github.com/tektoncd/pipeline/pkg/apis/pipeline/v1"
+	corev1 "k8s.io/api/core/v1"
 	rbac "k8s.io/api/rbac/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
-	"knative.dev/pkg/apis"
+	"k8s.io/client-go/tools/record"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
@@ -47,25 +51,61 @@ import (
 
 // adapter holds the objects needed to reconcile a Release.
 type adapter struct {
+	apiReader            client.Reader
 	client               client.Client
 	ctx                  context.Context
+	eventRecorder        record.EventRecorder
 	loader               loader.ObjectLoader
 	logger               *logr.Logger
+	pipelineRunConfig    utils.PipelineRunConfig
 	release              *v1alpha1.Release
 	releaseServiceConfig *v1alpha1.ReleaseServiceConfig
 	syncer               *syncer.Syncer
 	validations          []controller.ValidationFunction
 }
 
+const (
+	// defaultEnterpriseContractPolicyMaxParamSize is the size in bytes above which the EnterpriseContractPolicy spec is
+	// passed to the managed Pipeline through a ConfigMap instead of a param, unless overridden by the PipelineRunConfig.
+	defaultEnterpriseContractPolicyMaxParamSize = 64 * 1024
+
+	// defaultMaxArtifactSize is the size in bytes above which the artifacts extracted from the managed PipelineRun
+	// results are truncated, unless overridden by the PipelineRunConfig.
+	defaultMaxArtifactSize = 4 * 1024
+
+	// enterpriseContractPolicyConfigMapKey is the key of the ConfigMap data containing the EnterpriseContractPolicy spec.
+	enterpriseContractPolicyConfigMapKey = "policy"
+
+	// maxDeterministicNameAttempts is the number of names tried when creating a managed PipelineRun with a
+	// deterministic name before giving up.
+	maxDeterministicNameAttempts = 10
+
+	// truncatedArtifactSuffix is appended to the artifacts that were truncated for exceeding the maximum size.
+	truncatedArtifactSuffix = "...[truncated]"
+)
+
+// Reasons of the events recorded in the Release being processed when its state changes.
+const (
+	pipelineRunCreatedEventReason        = "PipelineRunCreated"
+	pipelineRunCreationFailedEventReason = "PipelineRunCreationFailed"
+	pipelineRunFailedEventReason         = "PipelineRunFailed"
+	releasedEventReason                  = "Released"
+	validatedEventReason                 = "Validated"
+	validationFailedEventReason          = "ValidationFailed"
+)
+
 // newAdapter creates and returns an adapter instance.
-func newAdapter(ctx context.Context, client client.Client, release *v1alpha1.Release, loader loader.ObjectLoader, logger *logr.Logger) *adapter {
+func newAdapter(ctx context.Context, client client.Client, apiReader client.Reader, eventRecorder record.EventRecorder, release *v1alpha1.Release, loader loader.ObjectLoader, logger *logr.Logger, pipelineRunConfig utils.PipelineRunConfig) *adapter {
 	releaseAdapter := &adapter{
-		client:  client,
-		ctx:     ctx,
-		loader:  loader,
-		logger:  logger,
-		release: release,
-		syncer:  syncer.NewSyncerWithContext(client, logger, ctx),
+		apiReader:         apiReader,
+		client:            client,
+		ctx:               ctx,
+		eventRecorder:     eventRecorder,
+		loader:            loader,
+		logger:            logger,
+		pipelineRunConfig: pipelineRunConfig,
+		release:           release,
+		syncer:            syncer.NewSyncerWithContext(client, logger, ctx),
 	}
 
 	releaseAdapter.validations = []controller.ValidationFunction{
@@ -74,6 +114,7 @@ func newAdapter(ctx context.Context, client client.Client, release *v1alpha1.Rel
 		releaseAdapter.validateApplication,
 		releaseAdapter.validateAuthor,
 		releaseAdapter.validatePipelineSource,
+		releaseAdapter.validateEnterpriseContractConfigMap,
 	}
 
 	return releaseAdapter
@@ -167,7 +208,178 @@ func (a *adapter) EnsureReleaseIsCompleted() (controller.OperationResult, error)
 
 	patch := client.MergeFrom(a.release.DeepCopy())
 	a.release.MarkReleased()
-	return controller.RequeueOnErrorOrContinue(a.client.Status().Patch(a.ctx, a.release, patch))
+	return controller.RequeueOnErrorOrContinue(a.patchStatusAndRecordEvent(patch, corev1.EventTypeNormal,
+		releasedEventReason, "Release completed successfully"))
+}
+
+// EnsureReleaseProcessingDeadlineIsEnforced is an operation that will ensure that a Release is not processed for longer
+// than its deadline, which is set in the ReleasePlanAdmission or, if not set there, in the operator configuration. The
+// deadline is measured from the start of the current processing attempt, so retried Releases get a new one. Once the
+// deadline is reached, the PipelineRun being processed is cancelled and the Release is marked as failed. Until then,
+// the Release is requeued so the deadline is enforced even if the PipelineRun never changes.
+func (a *adapter) EnsureReleaseProcessingDeadlineIsEnforced() (controller.OperationResult, error) {
+	startTime := a.release.GetProcessingStartTime()
+	if !a.release.IsReleasing() || a.release.HasReleaseFinished() || startTime == nil {
+		return controller.ContinueProcessing()
+	}
+
+	deadline := a.pipelineRunConfig.ProcessingDeadline
+	releasePlanAdmission, err := a.loader.GetActiveReleasePlanAdmissionFromRelease(a.ctx, a.client, a.release)
+	if err != nil && !errors.IsNotFound(err) && !stderrors.Is(err, loader.ErrReleasePlanAdmissionNotFound) {
+		return controller.RequeueWithError(err)
+	}
+	// Releases without a ReleasePlanAdmission, e.g. the ones only running a tenant pipeline, use the service deadline
+	if err == nil && releasePlanAdmission.Spec.ProcessingDeadline != nil {
+		deadline = releasePlanAdmission.Spec.ProcessingDeadline.Duration
+	}
+	if deadline <= 0 {
+		return controller.ContinueProcessing()
+	}
+
+	if remaining := time.Until(startTime.Add(deadline)); remaining > 0 {
+		return controller.RequeueAfter(remaining, nil)
+	}
+
+	message := fmt.Sprintf("Release processing exceeded the deadline of %s", deadline)
+	patch := client.MergeFrom(a.release.DeepCopy())
+
+	phases := []struct {
+		pipelineType         metadata.PipelineType
+		isProcessing         func() bool
+		markProcessingFailed func(string)
+	}{
+		{metadata.TenantCollectorsPipelineType, a.release.IsTenantCollectorsPipelineProcessing, a.release.MarkTenantCollectorsPipelineProcessingFailed},
+		{metadata.ManagedCollectorsPipelineType, a.release.IsManagedCollectorsPipelineProcessing, a.release.MarkManagedCollectorsPipelineProcessingFailed},
+		{metadata.TenantPipelineType, a.release.IsTenantPipelineProcessing, a.release.MarkTenantPipelineProcessingFailed},
+		{metadata.ManagedPipelineType, a.release.IsManagedPipelineProcessing, a.release.MarkManagedPipelineProcessingFailed},
+		{metadata.FinalPipelineType, a.release.IsFinalPipelineProcessing, a.release.MarkFinalPipelineProcessingFailed},
+	}
+	for _, phase := range phases {
+		if !phase.isProcessing() {
+			continue
+		}
+
+		pipelineRun, err := a.loader.GetReleasePipelineRun(a.ctx, a.client, a.release, phase.pipelineType)
+		if err != nil && !errors.IsNotFound(err) {
+			return controller.RequeueWithError(err)
+		}
+
+		if pipelineRun != nil {
+			// A PipelineRun that finished before being cancelled keeps its result, which is tracked on the next reconcile
+			if pipelineRun.IsDone() {
+				return controller.Requeue()
+			}
+
+			err = a.cancelPipelineRun(pipelineRun)
+			if err != nil {
+				return controller.RequeueWithError(err)
+			}
+
+			a.logger.Info(fmt.Sprintf("Cancelled %s Release PipelineRun after the processing deadline was reached", phase.pipelineType),
+				"PipelineRun.Name", pipelineRun.Name, "PipelineRun.Namespace", pipelineRun.Namespace)
+		}
+
+		phase.markProcessingFailed(message)
+	}
+
+	a.release.MarkReleaseFailedWithReason(v1alpha1.TimeoutReason, message)
+	err = a.client.Status().Patch(a.ctx, a.release, patch)
+	if err != nil {
+		return controller.RequeueWithError(err)
+	}
+
+	a.eventRecorder.Event(a.release, corev1.EventTypeWarning, v1alpha1.TimeoutReason.String(), message)
+
+	return controller.ContinueProcessing()
+}
+
+// EnsureReleaseIsRetried is an operation that will ensure that a failed Release is processed again when the retry
+// annotation is added to it. Only Releases that failed on the managed PipelineRun can be retried. In that case, a new
+// managed PipelineRun is created using the same Snapshot and ReleasePlan and the finalizer is removed from the failed
+// one. The annotation is removed once the Release is retried or if it can't be retried.
+func (a *adapter) EnsureReleaseIsRetried() (controller.OperationResult, error) {
+	if _, found := a.release.GetAnnotations()[metadata.RetryAnnotation]; !found {
+		return controller.ContinueProcessing()
+	}
+
+	if !a.release.IsRetriable() {
+		a.logger.Info("Ignoring retry request as the Release didn't fail on the managed pipelineRun")
+		return controller.RequeueOnErrorOrContinue(a.removeRetryAnnotation())
+	}
+
+	pipelineRun, err := a.loader.GetReleasePipelineRun(a.ctx, a.client, a.release, metadata.ManagedPipelineType)
+	if err != nil {
+		return controller.RequeueWithError(err)
+	}
+
+	var tenantRoleBinding *rbac.RoleBinding
+
+	// A PipelineRun created after the Release failed belongs to a retry that couldn't be registered
+	if pipelineRun == nil || !a.release.Status.CompletionTime.Before(&pipelineRun.CreationTimestamp) {
+		if pipelineRun != nil && !pipelineRun.IsDone() {
+			// The failed PipelineRun is still executing, so it can't be retried yet
+			return controller.Requeue()
+		}
+
+		resources, err := a.loader.GetProcessingResources(a.ctx, a.client, a.release)
+		if err != nil {
+			if errors.IsNotFound(err) || stderrors.Is(err, loader.ErrReleasePlanHasNoTarget) {
+				a.logger.Info("Ignoring retry request as the Release processing resources can't be found")
+				return controller.RequeueOnErrorOrContinue(a.removeRetryAnnotation())
+			}
+			return controller.RequeueWithError(err)
+		}
+
+		if resources.ReleasePlanAdmission.Spec.Pipeline == nil {
+			a.logger.Info("Ignoring retry request as there is no managed pipeline to run")
+			return controller.RequeueOnErrorOrContinue(a.removeRetryAnnotation())
+		}
+
+		if pipelineRun != nil && controllerutil.ContainsFinalizer(pipelineRun, metadata.ReleaseFinalizer) {
+			patch := client.MergeFrom(pipelineRun.DeepCopy())
+			controllerutil.RemoveFinalizer(pipelineRun, metadata.ReleaseFinalizer)
+			err = a.client.Patch(a.ctx, pipelineRun, patch)
+			if err != nil && !errors.IsNotFound(err) {
+				return controller.RequeueWithError(err)
+			}
+		}
+
+		// The RoleBinding created for the failed PipelineRun was removed when the Release finished
+		if resources.ReleasePlanAdmission.Spec.Pipeline.ServiceAccountName != "" {
+			tenantRoleBinding, err = a.createRoleBindingForClusterRole("release-pipeline-resource-role", resources.ReleasePlanAdmission.Spec.Origin, resources.ReleasePlanAdmission.Spec.Pipeline.ServiceAccountName, resources.ReleasePlanAdmission.Namespace)
+			if err != nil {
+				return controller.RequeueWithError(err)
+			}
+		}
+
+		pipelineRun, err = a.createManagedPipelineRun(resources)
+		if err != nil {
+			if utils.IsPipelineRunBuildError(err) {
+				a.logger.Info("Ignoring retry request as the managed pipelineRun can't be built", "Error", err.Error())
+				return controller.RequeueOnErrorOrContinue(a.removeRetryAnnotation())
+			}
+			return controller.RequeueWithError(err)
+		}
+
+		a.logger.Info(fmt.Sprintf("Retried %s Release PipelineRun", metadata.ManagedPipelineType),
+			"PipelineRun.Name", pipelineRun.Name, "PipelineRun.Namespace", pipelineRun.Namespace)
+	}
+
+	patch := client.MergeFrom(a.release.DeepCopy())
+	a.release.MarkRetried()
+	a.release.Status.ManagedProcessing.PipelineRun = fmt.Sprintf("%s%c%s",
+		pipelineRun.Namespace, types.Separator, pipelineRun.Name)
+	if tenantRoleBinding != nil {
+		a.release.Status.ManagedProcessing.RoleBindings.TenantRoleBinding = fmt.Sprintf("%s%c%s",
+			tenantRoleBinding.Namespace, types.Separator, tenantRoleBinding.Name)
+	}
+	a.release.MarkManagedPipelineProcessing()
+	err = a.client.Status().Patch(a.ctx, a.release, patch)
+	if err != nil {
+		return controller.RequeueWithError(err)
+	}
+
+	return controller.RequeueOnErrorOrContinue(a.removeRetryAnnotation())
 }
 
 // EnsureReleaseIsRunning is an operation that will ensure that a Release has not finished already and that
@@ -271,6 +483,7 @@ func (a *adapter) EnsureManagedCollectorsPipelineIsProcessed() (controller.Opera
 
 			a.logger.Info(fmt.Sprintf("Created %s Release PipelineRun", metadata.ManagedCollectorsPipelineType),
 				"PipelineRun.Name", pipelineRun.Name, "PipelineRun.Namespace", pipelineRun.Namespace)
+			a.recordPipelineRunCreatedEvent(metadata.ManagedCollectorsPipelineType, pipelineRun)
 		}
 
 		return controller.RequeueOnErrorOrContinue(a.registerManagedCollectorsProcessingData(pipelineRun, tenantRoleBinding, managedRoleBinding, secretRoleBinding))
@@ -365,6 +578,7 @@ func (a *adapter) EnsureTenantCollectorsPipelineIsProcessed() (controller.Operat
 
 			a.logger.Info(fmt.Sprintf("Created %s Release PipelineRun", metadata.TenantCollectorsPipelineType),
 				"PipelineRun.Name", pipelineRun.Name, "PipelineRun.Namespace", pipelineRun.Namespace)
+			a.recordPipelineRunCreatedEvent(metadata.TenantCollectorsPipelineType, pipelineRun)
 		}
 
 		return controller.RequeueOnErrorOrContinue(a.registerTenantCollectorsProcessingData(pipelineRun, tenantRoleBinding, secretRoleBinding))
@@ -434,11 +648,20 @@ func (a *adapter) EnsureTenantPipelineIsProcessed() (controller.OperationResult,
 
 			pipelineRun, err = a.createTenantPipelineRun(releasePlan, snapshot)
 			if err != nil {
+				if utils.IsPipelineRunBuildError(err) {
+					patch := client.MergeFrom(a.release.DeepCopy())
+					a.release.MarkTenantPipelineProcessing()
+					a.release.MarkTenantPipelineProcessingFailed(err.Error())
+					a.release.MarkReleaseFailed("Release processing failed on tenant pipelineRun")
+					return controller.RequeueOnErrorOrContinue(a.patchStatusAndRecordEvent(patch, corev1.EventTypeWarning,
+						pipelineRunCreationFailedEventReason, err.Error()))
+				}
 				return controller.RequeueWithError(err)
 			}
 
 			a.logger.Info(fmt.Sprintf("Created %s Release PipelineRun", metadata.TenantPipelineType),
 				"PipelineRun.Name", pipelineRun.Name, "PipelineRun.Namespace", pipelineRun.Namespace)
+			a.recordPipelineRunCreatedEvent(metadata.TenantPipelineType, pipelineRun)
 		}
 
 		return controller.RequeueOnErrorOrContinue(a.registerTenantProcessingData(pipelineRun))
@@ -475,7 +698,7 @@ func (a *adapter) EnsureManagedPipelineIsProcessed() (controller.OperationResult
 	if pipelineRun == nil || !a.release.IsManagedPipelineProcessing() {
 		resources, err := a.loader.GetProcessingResources(a.ctx, a.client, a.release)
 		if err != nil {
-			if strings.Contains(err.Error(), "no ReleasePlanAdmissions can be found") {
+			if stderrors.Is(err, loader.ErrReleasePlanHasNoTarget) {
 				// No ReleasePlanAdmission, so no managed pipeline to run
 				patch := client.MergeFrom(a.release.DeepCopy())
 				a.release.MarkManagedPipelineProcessingSkipped()
@@ -492,6 +715,53 @@ func (a *adapter) EnsureManagedPipelineIsProcessed() (controller.OperationResult
 				return controller.RequeueOnErrorOrContinue(a.client.Status().Patch(a.ctx, a.release, patch))
 			}
 
+			queued, err := a.queueManagedPipelineRunIfNeeded(resources.ReleasePlanAdmission)
+			if err != nil {
+				return controller.RequeueWithError(err)
+			}
+			if queued {
+				// The default rate limiter backs off the requeues until a slot frees up
+				return controller.Requeue()
+			}
+
+			serviceAccountName := resources.ReleasePlanAdmission.Spec.Pipeline.ServiceAccountName
+			if serviceAccountName == "" {
+				serviceAccountName = a.pipelineRunConfig.DefaultServiceAccount
+			}
+			if serviceAccountName != "" {
+				_, err = a.loader.GetServiceAccount(a.ctx, a.client, serviceAccountName, resources.ReleasePlanAdmission.Namespace)
+				if errors.IsNotFound(err) {
+					message := fmt.Sprintf("the ServiceAccount %s doesn't exist in the %s namespace",
+						serviceAccountName, resources.ReleasePlanAdmission.Namespace)
+					patch := client.MergeFrom(a.release.DeepCopy())
+					a.release.MarkManagedPipelineProcessing()
+					a.release.MarkManagedPipelineProcessingFailed(message)
+					a.release.MarkReleaseFailed("Release processing failed on managed pipelineRun")
+					return controller.RequeueOnErrorOrContinue(a.patchStatusAndRecordEvent(patch, corev1.EventTypeWarning,
+						pipelineRunCreationFailedEventReason, message))
+				}
+				if err != nil {
+					return controller.RequeueWithError(err)
+				}
+			}
+
+			if signingSecretWorkspace := resources.ReleasePlanAdmission.Spec.Pipeline.SigningSecretWorkspace; signingSecretWorkspace != nil {
+				_, err = a.loader.GetSecret(a.ctx, a.client, signingSecretWorkspace.SecretName, resources.ReleasePlanAdmission.Namespace)
+				if errors.IsNotFound(err) {
+					message := fmt.Sprintf("the Secret %s doesn't exist in the %s namespace",
+						signingSecretWorkspace.SecretName, resources.ReleasePlanAdmission.Namespace)
+					patch := client.MergeFrom(a.release.DeepCopy())
+					a.release.MarkManagedPipelineProcessing()
+					a.release.MarkManagedPipelineProcessingFailed(message)
+					a.release.MarkReleaseFailed("Release processing failed on managed pipelineRun")
+					return controller.RequeueOnErrorOrContinue(a.patchStatusAndRecordEvent(patch, corev1.EventTypeWarning,
+						pipelineRunCreationFailedEventReason, message))
+				}
+				if err != nil {
+					return controller.RequeueWithError(err)
+				}
+			}
+
 			// Only create a RoleBinding if a ServiceAccount is specified
 			if tenantRoleBinding == nil && resources.ReleasePlanAdmission.Spec.Pipeline.ServiceAccountName != "" {
 				// This string should probably be a constant somewhere
@@ -503,11 +773,20 @@ func (a *adapter) EnsureManagedPipelineIsProcessed() (controller.OperationResult
 
 			pipelineRun, err = a.createManagedPipelineRun(resources)
 			if err != nil {
+				if utils.IsPipelineRunBuildError(err) {
+					patch := client.MergeFrom(a.release.DeepCopy())
+					a.release.MarkManagedPipelineProcessing()
+					a.release.MarkManagedPipelineProcessingFailed(err.Error())
+					a.release.MarkReleaseFailed("Release processing failed on managed pipelineRun")
+					return controller.RequeueOnErrorOrContinue(a.patchStatusAndRecordEvent(patch, corev1.EventTypeWarning,
+						pipelineRunCreationFailedEventReason, err.Error()))
+				}
 				return controller.RequeueWithError(err)
 			}
 
 			a.logger.Info(fmt.Sprintf("Created %s Release PipelineRun", metadata.ManagedPipelineType),
 				"PipelineRun.Name", pipelineRun.Name, "PipelineRun.Namespace", pipelineRun.Namespace)
+			a.recordPipelineRunCreatedEvent(metadata.ManagedPipelineType, pipelineRun)
 		}
 
 		return controller.RequeueOnErrorOrContinue(a.registerManagedProcessingData(pipelineRun, tenantRoleBinding))
@@ -528,6 +807,12 @@ func (a *adapter) EnsureFinalPipelineIsProcessed() (controller.OperationResult,
 		return controller.RequeueWithError(err)
 	}
 
+	// The PipelineRun of an attempt previous to a retry is ignored so the final pipeline runs again
+	if pipelineRun != nil && a.release.Status.Attempts > 0 &&
+		pipelineRun.CreationTimestamp.Before(a.release.Status.ManagedProcessing.StartTime) {
+		pipelineRun = nil
+	}
+
 	if pipelineRun == nil || !a.release.IsFinalPipelineProcessing() {
 		releasePlan, err := a.loader.GetReleasePlan(a.ctx, a.client, a.release)
 		if err != nil {
@@ -549,11 +834,20 @@ func (a *adapter) EnsureFinalPipelineIsProcessed() (controller.OperationResult,
 
 			pipelineRun, err = a.createFinalPipelineRun(releasePlan, snapshot)
 			if err != nil {
+				if utils.IsPipelineRunBuildError(err) {
+					patch := client.MergeFrom(a.release.DeepCopy())
+					a.release.MarkFinalPipelineProcessing()
+					a.release.MarkFinalPipelineProcessingFailed(err.Error())
+					a.release.MarkReleaseFailed("Release processing failed on final pipelineRun")
+					return controller.RequeueOnErrorOrContinue(a.patchStatusAndRecordEvent(patch, corev1.EventTypeWarning,
+						pipelineRunCreationFailedEventReason, err.Error()))
+				}
 				return controller.RequeueWithError(err)
 			}
 
 			a.logger.Info(fmt.Sprintf("Created %s Release PipelineRun", metadata.FinalPipelineType),
 				"PipelineRun.Name", pipelineRun.Name, "PipelineRun.Namespace", pipelineRun.Namespace)
+			a.recordPipelineRunCreatedEvent(metadata.FinalPipelineType, pipelineRun)
 		}
 
 		return controller.RequeueOnErrorOrContinue(a.registerFinalProcessingData(pipelineRun))
@@ -609,6 +903,47 @@ func (a *adapter) EnsureApplicationMetadataIsSet() (controller.OperationResult,
 	return controller.ContinueProcessing()
 }
 
+// EnsureExpiredReleaseIsDeleted is an operation that will ensure that a finished Release set to be deleted on
+// expiration is deleted once its ExpirationTime is reached. Until then, the Release is requeued. If its ReleasePlan
+// expires existing Releases, the ExpirationTime is recalculated using the current ReleasePlan ExpirationTime.
+func (a *adapter) EnsureExpiredReleaseIsDeleted() (controller.OperationResult, error) {
+	if !a.release.HasReleaseFinished() || a.release.GetDeletionTimestamp() != nil {
+		return controller.ContinueProcessing()
+	}
+
+	releasePlan, err := a.loader.GetReleasePlan(a.ctx, a.client, a.release)
+	if err != nil && !errors.IsNotFound(err) {
+		return controller.RequeueWithError(err)
+	}
+
+	if err == nil && releasePlan.Spec.ExpirationTime != nil && releasePlan.Spec.ExpireExistingReleases {
+		expirationTime := a.release.CreationTimestamp.Add(releasePlan.Spec.ExpirationTime.Duration)
+		// The status is stored with a resolution of one second
+		if !a.release.Status.DeleteOnExpiration || a.release.Status.ExpirationTime == nil ||
+			a.release.Status.ExpirationTime.Unix() != expirationTime.Unix() {
+			patch := client.MergeFrom(a.release.DeepCopy())
+			a.release.SetExpirationTimeWithDeletion(releasePlan.Spec.ExpirationTime.Duration)
+			err = a.client.Status().Patch(a.ctx, a.release, patch)
+			if err != nil {
+				return controller.RequeueWithError(err)
+			}
+		}
+	}
+
+	if !a.release.Status.DeleteOnExpiration || a.release.Status.ExpirationTime == nil {
+		return controller.ContinueProcessing()
+	}
+
+	if !a.release.HasExpired() {
+		return controller.RequeueAfter(time.Until(a.release.Status.ExpirationTime.Time), nil)
+	}
+
+	a.logger.Info("Deleting Release as its expiration time was reached",
+		"ExpirationTime", a.release.Status.ExpirationTime.String())
+
+	return controller.RequeueOnErrorOrStop(client.IgnoreNotFound(a.client.Delete(a.ctx, a.release)))
+}
+
 // EnsureReleaseExpirationTimeIsAdded is an operation that ensures that a Release has the ExpirationTime set.
 func (a *adapter) EnsureReleaseExpirationTimeIsAdded() (controller.OperationResult, error) {
 	if a.release.Status.ExpirationTime == nil {
@@ -621,7 +956,11 @@ func (a *adapter) EnsureReleaseExpirationTimeIsAdded() (controller.OperationResu
 		if a.release.Spec.GracePeriodDays == 0 {
 			a.release.Spec.GracePeriodDays = releasePlan.Spec.ReleaseGracePeriodDays
 		}
-		a.release.SetExpirationTime(time.Duration(a.release.Spec.GracePeriodDays))
+		if releasePlan.Spec.ExpirationTime != nil {
+			a.release.SetExpirationTimeWithDeletion(releasePlan.Spec.ExpirationTime.Duration)
+		} else {
+			a.release.SetExpirationTime(time.Duration(a.release.Spec.GracePeriodDays))
+		}
 
 		return controller.RequeueOnErrorOrContinue(a.client.Status().Patch(a.ctx, a.release, patch))
 	}
@@ -639,16 +978,30 @@ func (a *adapter) EnsureReleaseIsValid() (controller.OperationResult, error) {
 		if result.Err != nil {
 			return controller.RequeueWithError(result.Err)
 		}
+		if a.release.IsReleasePlanAdmissionMissing() {
+			// The default rate limiter backs off the requeues until the ReleasePlanAdmission is created
+			err := a.client.Status().Patch(a.ctx, a.release, patch)
+			if err != nil {
+				return controller.RequeueWithError(err)
+			}
+			return controller.Requeue()
+		}
 		a.release.MarkReleaseFailed("Release validation failed")
 	}
 
 	// IsReleasing will be false if MarkReleaseFailed was called
 	if a.release.IsReleasing() {
+		if a.release.IsValid() {
+			return controller.RequeueOnErrorOrContinue(a.client.Status().Patch(a.ctx, a.release, patch))
+		}
+
 		a.release.MarkValidated()
-		return controller.RequeueOnErrorOrContinue(a.client.Status().Patch(a.ctx, a.release, patch))
+		return controller.RequeueOnErrorOrContinue(a.patchStatusAndRecordEvent(patch, corev1.EventTypeNormal,
+			validatedEventReason, "Release validated successfully"))
 	}
 
-	return controller.RequeueOnErrorOrStop(a.client.Status().Patch(a.ctx, a.release, patch))
+	return controller.RequeueOnErrorOrStop(a.patchStatusAndRecordEvent(patch, corev1.EventTypeWarning,
+		validationFailedEventReason, a.release.GetValidationMessage()))
 }
 
 // EnsureTenantPipelineProcessingIsTracked is an operation that will ensure that the Release Tenant PipelineRun status
@@ -662,6 +1015,13 @@ func (a *adapter) EnsureTenantPipelineProcessingIsTracked() (controller.Operatio
 	if err != nil {
 		return controller.RequeueWithError(err)
 	}
+
+	deleted, err := a.failReleaseIfPipelineRunDeleted(pipelineRun, a.release.Status.TenantProcessing.PipelineRun,
+		a.release.MarkTenantPipelineProcessingFailed)
+	if err != nil || deleted {
+		return controller.RequeueOnErrorOrContinue(err)
+	}
+
 	if pipelineRun != nil {
 		err = a.registerTenantProcessingStatus(pipelineRun)
 		if err != nil {
@@ -683,7 +1043,31 @@ func (a *adapter) EnsureManagedPipelineProcessingIsTracked() (controller.Operati
 	if err != nil {
 		return controller.RequeueWithError(err)
 	}
+
+	deleted, err := a.failReleaseIfPipelineRunDeleted(pipelineRun, a.release.Status.ManagedProcessing.PipelineRun,
+		a.release.MarkManagedPipelineProcessingFailed)
+	if err != nil || deleted {
+		return controller.RequeueOnErrorOrContinue(err)
+	}
+
 	if pipelineRun != nil {
+		// After a retry, the cache might still return the failed PipelineRun instead of the one registered in the status
+		if (a.release.Status.ManagedProcessing.Retries > 0 || a.release.Status.Attempts > 0) &&
+			a.release.Status.ManagedProcessing.PipelineRun !=
+				fmt.Sprintf("%s%c%s", pipelineRun.Namespace, types.Separator, pipelineRun.Name) {
+			return controller.Requeue()
+		}
+
+		if pipelineRun.IsDone() && !tekton.HasPipelineRunSucceeded(pipelineRun) {
+			retried, err := a.retryManagedPipelineRun(pipelineRun)
+			if err != nil {
+				return controller.RequeueWithError(err)
+			}
+			if retried {
+				return controller.ContinueProcessing()
+			}
+		}
+
 		err = a.registerManagedProcessingStatus(pipelineRun)
 		if err != nil {
 			return controller.RequeueWithError(err)
@@ -704,7 +1088,20 @@ func (a *adapter) EnsureFinalPipelineProcessingIsTracked() (controller.Operation
 	if err != nil {
 		return controller.RequeueWithError(err)
 	}
+
+	deleted, err := a.failReleaseIfPipelineRunDeleted(pipelineRun, a.release.Status.FinalProcessing.PipelineRun,
+		a.release.MarkFinalPipelineProcessingFailed)
+	if err != nil || deleted {
+		return controller.RequeueOnErrorOrContinue(err)
+	}
+
 	if pipelineRun != nil {
+		// After a retry, the cache might still return the PipelineRun of the previous attempt
+		if a.release.Status.Attempts > 0 && a.release.Status.FinalProcessing.PipelineRun !=
+			fmt.Sprintf("%s%c%s", pipelineRun.Namespace, types.Separator, pipelineRun.Name) {
+			return controller.Requeue()
+		}
+
 		err = a.registerFinalProcessingStatus(pipelineRun)
 		if err != nil {
 			return controller.RequeueWithError(err)
@@ -772,8 +1169,95 @@ func (a *adapter) EnsureReleaseProcessingResourcesAreCleanedUp() (controller.Ope
 	return controller.RequeueOnErrorOrContinue(a.finalizeRelease(false))
 }
 
+// EnsureReleasePipelineRunsAreGarbageCollected is an operation that will ensure that the PipelineRuns of a finished
+// Release are not kept forever. The release finalizer is removed from all the finished PipelineRuns, which also covers
+// the cases in which the completion of the Release was missed. If the ReleasePlanAdmission sets a grace period, the
+// PipelineRuns in its namespace are deleted once it expires unless they have the keep annotation.
+func (a *adapter) EnsureReleasePipelineRunsAreGarbageCollected() (controller.OperationResult, error) {
+	if !a.release.HasReleaseFinished() {
+		return controller.ContinueProcessing()
+	}
+
+	pipelineRuns, err := a.loader.GetReleasePipelineRuns(a.ctx, a.client, a.release)
+	if err != nil {
+		return controller.RequeueWithError(err)
+	}
+
+	var gracePeriod time.Duration
+	var managedNamespace string
+	releasePlanAdmission, err := a.loader.GetActiveReleasePlanAdmissionFromRelease(a.ctx, a.client, a.release)
+	if err != nil && !errors.IsNotFound(err) && !stderrors.Is(err, loader.ErrReleasePlanAdmissionNotFound) {
+		return controller.RequeueWithError(err)
+	}
+	if err == nil && releasePlanAdmission.Spec.ReleaseGracePeriodDays > 0 {
+		gracePeriod = time.Duration(releasePlanAdmission.Spec.ReleaseGracePeriodDays) * 24 * time.Hour
+		managedNamespace = releasePlanAdmission.Namespace
+	}
+
+	var requeueAfter time.Duration
+	for i := range pipelineRuns.Items {
+		pipelineRun := &pipelineRuns.Items[i]
+		if !pipelineRun.IsDone() {
+			continue
+		}
+
+		err = a.cleanupProcessingResources(pipelineRun)
+		if err != nil && !errors.IsNotFound(err) {
+			return controller.RequeueWithError(err)
+		}
+
+		if gracePeriod == 0 || pipelineRun.Namespace != managedNamespace || pipelineRun.DeletionTimestamp != nil {
+			continue
+		}
+
+		if _, found := pipelineRun.GetAnnotations()[metadata.KeepAnnotation]; found {
+			continue
+		}
+
+		completionTime := pipelineRun.CreationTimestamp.Time
+		if pipelineRun.Status.CompletionTime != nil {
+			completionTime = pipelineRun.Status.CompletionTime.Time
+		}
+
+		if remaining := time.Until(completionTime.Add(gracePeriod)); remaining > 0 {
+			if requeueAfter == 0 || remaining < requeueAfter {
+				requeueAfter = remaining
+			}
+			continue
+		}
+
+		err = a.client.Delete(a.ctx, pipelineRun)
+		if err != nil && !errors.IsNotFound(err) {
+			return controller.RequeueWithError(err)
+		}
+
+		a.logger.Info("Deleted Release PipelineRun after its grace period expired",
+			"PipelineRun.Name", pipelineRun.Name, "PipelineRun.Namespace", pipelineRun.Namespace)
+	}
+
+	if requeueAfter > 0 {
+		return controller.RequeueAfter(requeueAfter, nil)
+	}
+
+	return controller.ContinueProcessing()
+}
+
+// cancelPipelineRun cancels the given PipelineRun unless it's already cancelled. The patch uses optimistic locking, so
+// it fails if the PipelineRun changed since it was read, e.g., because it finished right before being cancelled.
+func (a *adapter) cancelPipelineRun(pipelineRun *tektonv1.PipelineRun) error {
+	if pipelineRun.IsCancelled() {
+		return nil
+	}
+
+	patch := client.MergeFromWithOptions(pipelineRun.DeepCopy(), client.MergeFromWithOptimisticLock{})
+	pipelineRun.Spec.Status = tektonv1.PipelineRunSpecStatusCancelled
+
+	return a.client.Patch(a.ctx, pipelineRun, patch)
+}
+
 // cleanupProcessingResources removes the finalizer from the PipelineRun created for the Release Processing
-// and removes the roleBindings and roles that was created in order for the PipelineRun to succeed.
+// and removes the roleBindings, roles and EnterpriseContractPolicy ConfigMaps that were created in order for the
+// PipelineRun to succeed.
 func (a *adapter) cleanupProcessingResources(pipelineRun *tektonv1.PipelineRun, roleBindings ...*rbac.RoleBinding) error {
 	for _, roleBinding := range roleBindings {
 		if roleBinding == nil {
@@ -813,6 +1297,11 @@ func (a *adapter) cleanupProcessingResources(pipelineRun *tektonv1.PipelineRun,
 				return err
 			}
 		}
+
+		err := a.deleteEnterpriseContractPolicyConfigMaps(pipelineRun)
+		if err != nil {
+			return err
+		}
 	}
 
 	return nil
@@ -828,16 +1317,19 @@ func (a *adapter) getCollectorsPipelineRunBuilder(pipelineType metadata.Pipeline
 	}
 
 	return utils.NewPipelineRunBuilder(pipelineType.String(), namespace).
-		WithAnnotations(metadata.GetAnnotationsWithPrefix(a.release, integrationgitops.PipelinesAsCodePrefix)).
-		WithFinalizer(metadata.ReleaseFinalizer).
+		WithAnnotationsWithPrefixes(a.release, a.pipelineRunConfig.AnnotationPrefixes,
+			a.pipelineRunConfig.PipelinesAsCodeExcludedAnnotations...).
+		WithDefaults(a.pipelineRunConfig).
+		WithFinalizerOptions(metadata.ReleaseFinalizer, a.getFinalizerOptions()).
 		WithLabels(map[string]string{
 			metadata.PipelinesTypeLabel:    pipelineType.String(),
 			metadata.ServiceNameLabel:      metadata.ServiceName,
-			metadata.ReleaseNameLabel:      a.release.Name,
-			metadata.ReleaseNamespaceLabel: a.release.Namespace,
+			metadata.ReleaseNameLabel:      metadata.SanitizeLabelValue(a.release.Name),
+			metadata.ReleaseNamespaceLabel: metadata.SanitizeLabelValue(a.release.Namespace),
 		}).
 		WithObjectReferences(a.release).
 		WithOwner(a.release).
+		WithOwnerReference(a.release, a.client.Scheme()).
 		WithParams(
 			tektonv1.Param{
 				Name: "previousRelease",
@@ -847,26 +1339,12 @@ func (a *adapter) getCollectorsPipelineRunBuilder(pipelineType metadata.Pipeline
 				},
 			},
 		).
-		WithPipelineRef((&utils.PipelineRef{
-			Resolver: "git",
-			Params: []utils.Param{
-				{
-					Name:  "url",
-					Value: url,
-				},
-				{
-					Name:  "revision",
-					Value: revision,
-				},
-				{
-					Name:  "pathInRepo",
-					Value: v1alpha1.DefaultCollectorPipelinePath,
-				},
-			},
-		}).ToTektonPipelineRef()).
+		WithPipelineRef(utils.NewGitPipelineRef(url, revision, v1alpha1.DefaultCollectorPipelinePath).ToTektonPipelineRef()).
+		WithPipelinesAsCodeAnnotations(a.release, a.pipelineRunConfig.PipelinesAsCodeExcludedAnnotations...).
 		WithWorkspaceFromVolumeTemplate(
-			os.Getenv("DEFAULT_RELEASE_WORKSPACE_NAME"),
-			os.Getenv("DEFAULT_RELEASE_WORKSPACE_SIZE"),
+			a.pipelineRunConfig.WorkspaceName,
+			a.pipelineRunConfig.WorkspaceSize,
+			"",
 		)
 }
 
@@ -971,27 +1449,41 @@ func (a *adapter) createTenantCollectorsPipelineRun(releasePlan *v1alpha1.Releas
 // PipelineRun.
 func (a *adapter) createFinalPipelineRun(releasePlan *v1alpha1.ReleasePlan, snapshot *applicationapiv1alpha1.Snapshot) (*tektonv1.PipelineRun, error) {
 	pipelineRun, err := utils.NewPipelineRunBuilder(metadata.FinalPipelineType.String(), releasePlan.Namespace).
-		WithAnnotations(metadata.GetAnnotationsWithPrefix(a.release, integrationgitops.PipelinesAsCodePrefix)).
-		WithFinalizer(metadata.ReleaseFinalizer).
+		// Params are applied in increasing order of precedence: ReleasePlan params first, then the Release ones and
+		// finally the ones generated by the service in the rest of the chain, as later params replace earlier ones
+		WithParams(releasePlan.Spec.FinalPipeline.GetTektonParams()...).
+		WithParams(a.release.Spec.Params...).
+		WithAnnotationsWithPrefixes(a.release, a.pipelineRunConfig.AnnotationPrefixes,
+			a.pipelineRunConfig.PipelinesAsCodeExcludedAnnotations...).
+		WithApplicationSnapshot(snapshot).
+		WithData(releasePlan.Spec.Data, a.release.Spec.Data).
+		WithDefaults(a.pipelineRunConfig).
+		WithFinalizerOptions(metadata.ReleaseFinalizer, a.getFinalizerOptions()).
 		WithLabels(map[string]string{
-			metadata.ApplicationNameLabel:  releasePlan.Spec.Application,
+			metadata.ApplicationNameLabel:  metadata.SanitizeLabelValue(releasePlan.Spec.Application),
+			metadata.AuthorLabel:           metadata.SanitizeLabelValue(a.release.Status.Attribution.Author),
 			metadata.PipelinesTypeLabel:    metadata.FinalPipelineType.String(),
 			metadata.ServiceNameLabel:      metadata.ServiceName,
-			metadata.ReleaseNameLabel:      a.release.Name,
-			metadata.ReleaseNamespaceLabel: a.release.Namespace,
-			metadata.ReleaseSnapshotLabel:  a.release.Spec.Snapshot,
+			metadata.ReleaseNameLabel:      metadata.SanitizeLabelValue(a.release.Name),
+			metadata.ReleaseNamespaceLabel: metadata.SanitizeLabelValue(a.release.Namespace),
+			metadata.ReleaseSnapshotLabel:  metadata.SanitizeLabelValue(a.release.Spec.Snapshot),
 		}).
 		WithObjectReferences(a.release, releasePlan, snapshot).
-		WithParams(releasePlan.Spec.FinalPipeline.GetTektonParams()...).
 		WithOwner(a.release).
+		WithOwnerReference(a.release, a.client.Scheme()).
 		WithPipelineRef(releasePlan.Spec.FinalPipeline.PipelineRef.ToTektonPipelineRef()).
+		WithPipelinesAsCodeAnnotations(a.release, a.pipelineRunConfig.PipelinesAsCodeExcludedAnnotations...).
+		WithPodTemplate(releasePlan.Spec.FinalPipeline.NodeSelector, releasePlan.Spec.FinalPipeline.Tolerations).
+		WithSchedulingOptions(releasePlan.Spec.FinalPipeline.PriorityClassName, releasePlan.Spec.FinalPipeline.SchedulerName).
 		WithServiceAccount(releasePlan.Spec.FinalPipeline.ServiceAccountName).
 		WithTaskRunSpecs(releasePlan.Spec.FinalPipeline.TaskRunSpecs...).
 		WithTimeouts(&releasePlan.Spec.FinalPipeline.Timeouts, &a.releaseServiceConfig.Spec.DefaultTimeouts).
 		WithWorkspaceFromVolumeTemplate(
-			os.Getenv("DEFAULT_RELEASE_WORKSPACE_NAME"),
-			os.Getenv("DEFAULT_RELEASE_WORKSPACE_SIZE"),
+			a.pipelineRunConfig.WorkspaceName,
+			releasePlan.Spec.FinalPipeline.GetWorkspaceSize(a.pipelineRunConfig.WorkspaceSize),
+			releasePlan.Spec.FinalPipeline.StorageClass,
 		).
+		WithWorkspaces(releasePlan.Spec.FinalPipeline.Workspaces...).
 		Build()
 
 	if err != nil {
@@ -1009,55 +1501,104 @@ func (a *adapter) createFinalPipelineRun(releasePlan *v1alpha1.ReleasePlan, snap
 // createManagedPipelineRun creates and returns a new managed Release PipelineRun. The new PipelineRun will include owner
 // annotations, so it triggers Release reconciles whenever it changes. The Pipeline information and the parameters to it
 // will be extracted from the given ReleasePlanAdmission. The Release's Snapshot will also be passed to the release
-// PipelineRun.
+// PipelineRun. If the ReleasePlanAdmission enables deterministic names, the PipelineRun is named after the Release and
+// the attempt used is stored in the Release status.
 func (a *adapter) createManagedPipelineRun(resources *loader.ProcessingResources) (*tektonv1.PipelineRun, error) {
-	builder := utils.NewPipelineRunBuilder(metadata.ManagedPipelineType.String(), resources.ReleasePlanAdmission.Namespace).
-		WithAnnotations(metadata.GetAnnotationsWithPrefix(a.release, integrationgitops.PipelinesAsCodePrefix)).
-		WithFinalizer(metadata.ReleaseFinalizer).
-		WithLabels(map[string]string{
-			metadata.ApplicationNameLabel:  resources.ReleasePlan.Spec.Application,
-			metadata.PipelinesTypeLabel:    metadata.ManagedPipelineType.String(),
-			metadata.ServiceNameLabel:      metadata.ServiceName,
-			metadata.ReleaseNameLabel:      a.release.Name,
-			metadata.ReleaseNamespaceLabel: a.release.Namespace,
-			metadata.ReleaseSnapshotLabel:  a.release.Spec.Snapshot,
-		}).
-		WithObjectReferences(a.release, resources.ReleasePlan, resources.ReleasePlanAdmission, a.releaseServiceConfig,
-			resources.Snapshot).
-		WithObjectSpecsAsJson(resources.EnterpriseContractPolicy).
-		WithOwner(a.release).
-		WithParamsFromConfigMap(resources.EnterpriseContractConfigMap, []string{"verify_ec_task_bundle"}).
-		WithParamsFromConfigMap(resources.EnterpriseContractConfigMap, []string{"verify_ec_task_git_revision"}).
-		WithPipelineRef(resources.ReleasePlanAdmission.Spec.Pipeline.PipelineRef.ToTektonPipelineRef()).
-		WithServiceAccount(resources.ReleasePlanAdmission.Spec.Pipeline.ServiceAccountName).
-		WithTaskRunSpecs(resources.ReleasePlanAdmission.Spec.Pipeline.TaskRunSpecs...).
-		WithTimeouts(&resources.ReleasePlanAdmission.Spec.Pipeline.Timeouts, &a.releaseServiceConfig.Spec.DefaultTimeouts)
-
-	url, revision, pathInRepo, err := resources.ReleasePlanAdmission.Spec.Pipeline.PipelineRef.GetGitResolverParams()
-	if err == nil && a.releaseServiceConfig.IsPipelineOverridden(url, revision, pathInRepo) {
-		builder.WithEmptyDirVolume(
-			os.Getenv("DEFAULT_RELEASE_WORKSPACE_NAME"),
-			os.Getenv("DEFAULT_RELEASE_WORKSPACE_SIZE"),
-		)
-	} else {
-		builder.WithWorkspaceFromVolumeTemplate(
-			os.Getenv("DEFAULT_RELEASE_WORKSPACE_NAME"),
-			os.Getenv("DEFAULT_RELEASE_WORKSPACE_SIZE"),
-		)
+	policyConfigMap, err := a.createEnterpriseContractPolicyConfigMapIfNeeded(resources)
+	if err != nil {
+		return nil, err
 	}
 
+	builder := newManagedPipelineRunBuilder(a.release, resources, a.releaseServiceConfig, a.pipelineRunConfig,
+		a.client.Scheme(), policyConfigMap)
+
+	deterministicName := resources.ReleasePlanAdmission.Spec.DeterministicPipelineRunName
+	firstAttempt := a.release.Status.ManagedProcessing.Attempt + 1
+	attempt := firstAttempt
+
 	var pipelineRun *tektonv1.PipelineRun
 	pipelineRun, err = builder.Build()
+	if err == nil {
+		err = a.client.Create(a.ctx, pipelineRun)
+		// A PipelineRun from a previous attempt may still exist, so the attempt is bumped until a free name is found
+		for deterministicName && errors.IsAlreadyExists(err) && attempt < firstAttempt+maxDeterministicNameAttempts-1 {
+			attempt++
+			pipelineRun, err = builder.WithDeterministicName(a.release.Name, attempt).Build()
+			if err == nil {
+				err = a.client.Create(a.ctx, pipelineRun)
+			}
+		}
+	}
 	if err != nil {
+		if policyConfigMap != nil {
+			_ = a.client.Delete(a.ctx, policyConfigMap)
+		}
 		return nil, err
 	}
 
-	err = a.client.Create(a.ctx, pipelineRun)
+	if policyConfigMap != nil {
+		// The ConfigMap is owned by the PipelineRun as soon as it exists, so it gets garbage collected alongside it.
+		// If this fails, the ConfigMap is deleted when the processing resources of the Release are cleaned up
+		patch := client.MergeFrom(policyConfigMap.DeepCopy())
+		err = ctrl.SetControllerReference(pipelineRun, policyConfigMap, a.client.Scheme())
+		if err != nil {
+			return nil, err
+		}
+		err = a.client.Patch(a.ctx, policyConfigMap, patch)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if deterministicName {
+		patch := client.MergeFrom(a.release.DeepCopy())
+		a.release.Status.ManagedProcessing.Attempt = attempt
+		err = a.client.Status().Patch(a.ctx, a.release, patch)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return pipelineRun, nil
+}
+
+// createEnterpriseContractPolicyConfigMapIfNeeded creates a ConfigMap in the managed namespace containing the
+// EnterpriseContractPolicy spec if its JSON representation exceeds the maximum param size. If the spec is small enough
+// to be passed as a param, nil is returned.
+func (a *adapter) createEnterpriseContractPolicyConfigMapIfNeeded(resources *loader.ProcessingResources) (*corev1.ConfigMap, error) {
+	if resources.EnterpriseContractPolicy == nil {
+		return nil, nil
+	}
+
+	policy, err := json.Marshal(resources.EnterpriseContractPolicy.Spec)
 	if err != nil {
 		return nil, err
 	}
+	if len(policy) <= a.getEnterpriseContractPolicyMaxParamSize() {
+		return nil, nil
+	}
 
-	return pipelineRun, nil
+	configMap := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			GenerateName: "ec-policy-",
+			Namespace:    resources.ReleasePlanAdmission.Namespace,
+			Labels: map[string]string{
+				metadata.ServiceNameLabel:      metadata.ServiceName,
+				metadata.ReleaseNameLabel:      metadata.SanitizeLabelValue(a.release.Name),
+				metadata.ReleaseNamespaceLabel: metadata.SanitizeLabelValue(a.release.Namespace),
+			},
+		},
+		Data: map[string]string{
+			enterpriseContractPolicyConfigMapKey: string(policy),
+		},
+	}
+
+	err = a.client.Create(a.ctx, configMap)
+	if err != nil {
+		return nil, err
+	}
+
+	return configMap, nil
 }
 
 // createTenantPipelineRun creates and returns a new tenant Release PipelineRun. The new PipelineRun will include owner
@@ -1066,27 +1607,41 @@ func (a *adapter) createManagedPipelineRun(resources *loader.ProcessingResources
 // PipelineRun.
 func (a *adapter) createTenantPipelineRun(releasePlan *v1alpha1.ReleasePlan, snapshot *applicationapiv1alpha1.Snapshot) (*tektonv1.PipelineRun, error) {
 	pipelineRun, err := utils.NewPipelineRunBuilder(metadata.TenantPipelineType.String(), releasePlan.Namespace).
-		WithAnnotations(metadata.GetAnnotationsWithPrefix(a.release, integrationgitops.PipelinesAsCodePrefix)).
-		WithFinalizer(metadata.ReleaseFinalizer).
+		// Params are applied in increasing order of precedence: ReleasePlan params first, then the Release ones and
+		// finally the ones generated by the service in the rest of the chain, as later params replace earlier ones
+		WithParams(releasePlan.Spec.TenantPipeline.GetTektonParams()...).
+		WithParams(a.release.Spec.Params...).
+		WithAnnotationsWithPrefixes(a.release, a.pipelineRunConfig.AnnotationPrefixes,
+			a.pipelineRunConfig.PipelinesAsCodeExcludedAnnotations...).
+		WithApplicationSnapshot(snapshot).
+		WithData(releasePlan.Spec.Data, a.release.Spec.Data).
+		WithDefaults(a.pipelineRunConfig).
+		WithFinalizerOptions(metadata.ReleaseFinalizer, a.getFinalizerOptions()).
 		WithLabels(map[string]string{
-			metadata.ApplicationNameLabel:  releasePlan.Spec.Application,
+			metadata.ApplicationNameLabel:  metadata.SanitizeLabelValue(releasePlan.Spec.Application),
+			metadata.AuthorLabel:           metadata.SanitizeLabelValue(a.release.Status.Attribution.Author),
 			metadata.PipelinesTypeLabel:    metadata.TenantPipelineType.String(),
 			metadata.ServiceNameLabel:      metadata.ServiceName,
-			metadata.ReleaseNameLabel:      a.release.Name,
-			metadata.ReleaseNamespaceLabel: a.release.Namespace,
-			metadata.ReleaseSnapshotLabel:  a.release.Spec.Snapshot,
+			metadata.ReleaseNameLabel:      metadata.SanitizeLabelValue(a.release.Name),
+			metadata.ReleaseNamespaceLabel: metadata.SanitizeLabelValue(a.release.Namespace),
+			metadata.ReleaseSnapshotLabel:  metadata.SanitizeLabelValue(a.release.Spec.Snapshot),
 		}).
 		WithObjectReferences(a.release, releasePlan, snapshot).
-		WithParams(releasePlan.Spec.TenantPipeline.GetTektonParams()...).
 		WithOwner(a.release).
+		WithOwnerReference(a.release, a.client.Scheme()).
 		WithPipelineRef(releasePlan.Spec.TenantPipeline.PipelineRef.ToTektonPipelineRef()).
+		WithPipelinesAsCodeAnnotations(a.release, a.pipelineRunConfig.PipelinesAsCodeExcludedAnnotations...).
+		WithPodTemplate(releasePlan.Spec.TenantPipeline.NodeSelector, releasePlan.Spec.TenantPipeline.Tolerations).
+		WithSchedulingOptions(releasePlan.Spec.TenantPipeline.PriorityClassName, releasePlan.Spec.TenantPipeline.SchedulerName).
 		WithServiceAccount(releasePlan.Spec.TenantPipeline.ServiceAccountName).
 		WithTaskRunSpecs(releasePlan.Spec.TenantPipeline.TaskRunSpecs...).
 		WithTimeouts(&releasePlan.Spec.TenantPipeline.Timeouts, &a.releaseServiceConfig.Spec.DefaultTimeouts).
 		WithWorkspaceFromVolumeTemplate(
-			os.Getenv("DEFAULT_RELEASE_WORKSPACE_NAME"),
-			os.Getenv("DEFAULT_RELEASE_WORKSPACE_SIZE"),
+			a.pipelineRunConfig.WorkspaceName,
+			releasePlan.Spec.TenantPipeline.GetWorkspaceSize(a.pipelineRunConfig.WorkspaceSize),
+			releasePlan.Spec.TenantPipeline.StorageClass,
 		).
+		WithWorkspaces(releasePlan.Spec.TenantPipeline.Workspaces...).
 		Build()
 
 	if err != nil {
@@ -1181,6 +1736,82 @@ func (a *adapter) createRoleBindingForClusterRole(clusterRole, roleBindingNamesp
 	return roleBinding, nil
 }
 
+// deleteEnterpriseContractPolicyConfigMaps deletes the EnterpriseContractPolicy ConfigMaps created for the Release in
+// the namespace of the given managed PipelineRun. ConfigMaps owned by other PipelineRuns are kept, while the ones that
+// didn't get an owner reference are deleted too, so they don't outlive the Release processing.
+func (a *adapter) deleteEnterpriseContractPolicyConfigMaps(pipelineRun *tektonv1.PipelineRun) error {
+	if pipelineRun.GetLabels()[metadata.PipelinesTypeLabel] != metadata.ManagedPipelineType.String() {
+		return nil
+	}
+
+	configMaps := &corev1.ConfigMapList{}
+	err := a.client.List(a.ctx, configMaps,
+		client.InNamespace(pipelineRun.Namespace),
+		client.MatchingLabels{
+			metadata.ServiceNameLabel:      metadata.ServiceName,
+			metadata.ReleaseNameLabel:      metadata.SanitizeLabelValue(a.release.Name),
+			metadata.ReleaseNamespaceLabel: metadata.SanitizeLabelValue(a.release.Namespace),
+		})
+	if err != nil {
+		return err
+	}
+
+	for i := range configMaps.Items {
+		configMap := &configMaps.Items[i]
+		if owner := metav1.GetControllerOf(configMap); owner != nil && owner.UID != pipelineRun.UID {
+			continue
+		}
+
+		err = a.client.Delete(a.ctx, configMap)
+		if err != nil && !errors.IsNotFound(err) {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// failReleaseIfPipelineRunDeleted marks the Release being processed as failed if its PipelineRun was deleted before
+// finishing, either because it's being deleted or because it can't be found even though it was registered in the
+// Release status. The given function is used to mark the processing phase the PipelineRun belongs to as failed. A
+// boolean indicating whether the PipelineRun was deleted is returned.
+func (a *adapter) failReleaseIfPipelineRunDeleted(pipelineRun *tektonv1.PipelineRun, registeredPipelineRun string, markProcessingFailed func(string)) (bool, error) {
+	if pipelineRun != nil {
+		if pipelineRun.IsDone() || pipelineRun.DeletionTimestamp == nil {
+			return false, nil
+		}
+		registeredPipelineRun = fmt.Sprintf("%s%c%s", pipelineRun.Namespace, types.Separator, pipelineRun.Name)
+	} else {
+		values := strings.SplitN(registeredPipelineRun, string(types.Separator), 2)
+		if len(values) < 2 {
+			return false, nil
+		}
+
+		// The cache might not have caught up with a PipelineRun created during this reconcile, so the API server
+		// is queried directly to confirm it's gone
+		err := a.apiReader.Get(a.ctx, types.NamespacedName{Namespace: values[0], Name: values[1]}, &tektonv1.PipelineRun{})
+		if err == nil || !errors.IsNotFound(err) {
+			return false, err
+		}
+	}
+
+	message := fmt.Sprintf("PipelineRun %s was deleted before finishing", registeredPipelineRun)
+	a.logger.Info(message)
+
+	patch := client.MergeFrom(a.release.DeepCopy())
+	markProcessingFailed(message)
+	a.release.MarkReleaseFailedWithReason(v1alpha1.PipelineRunDeletedReason, message)
+
+	err := a.client.Status().Patch(a.ctx, a.release, patch)
+	if err != nil {
+		return true, err
+	}
+
+	a.eventRecorder.Event(a.release, corev1.EventTypeWarning, v1alpha1.PipelineRunDeletedReason.String(), message)
+
+	return true, nil
+}
+
 // finalizeRelease will finalize the Release being processed, removing the associated resources. The pipelineRuns are optionally
 // deleted so that EnsureReleaseProcessingResourcesAreCleanedUp can call this and just remove the finalizers, but
 // EnsureFinalizersAreCalled will remove the finalizers and delete the pipelineRuns. If the pipelineRuns were deleted in
@@ -1323,6 +1954,109 @@ func (a *adapter) getEmptyReleaseServiceConfig(namespace string) *v1alpha1.Relea
 	return releaseServiceConfig
 }
 
+// getEnterpriseContractPolicyMaxParamSize returns the size in bytes above which the EnterpriseContractPolicy spec is
+// passed to the managed Pipeline through a ConfigMap, falling back to the default if the PipelineRunConfig doesn't set it.
+func (a *adapter) getEnterpriseContractPolicyMaxParamSize() int {
+	if a.pipelineRunConfig.EnterpriseContractPolicyMaxParamSize > 0 {
+		return a.pipelineRunConfig.EnterpriseContractPolicyMaxParamSize
+	}
+
+	return defaultEnterpriseContractPolicyMaxParamSize
+}
+
+// getFinalizerOptions returns the options to use when adding the Release finalizer to the PipelineRuns created for the
+// Release, so it's skipped if the PipelineRunConfig says so.
+func (a *adapter) getFinalizerOptions() utils.FinalizerOptions {
+	return utils.FinalizerOptions{
+		SkipFinalizer: a.pipelineRunConfig.SkipPipelineRunFinalizer,
+	}
+}
+
+// getMaxArtifactSize returns the size in bytes above which the artifacts extracted from the managed PipelineRun results
+// are truncated, falling back to the default if the PipelineRunConfig doesn't set it.
+func (a *adapter) getMaxArtifactSize() int {
+	if a.pipelineRunConfig.MaxArtifactSize > 0 {
+		return a.pipelineRunConfig.MaxArtifactSize
+	}
+
+	return defaultMaxArtifactSize
+}
+
+// patchStatusAndRecordEvent patches the status of the Release being processed and, if the patch succeeds, records an
+// event with the given type, reason and message in it. The event is only recorded once the new state is stored so it
+// is not recorded again when the Release is reconciled after a failed patch.
+func (a *adapter) patchStatusAndRecordEvent(patch client.Patch, eventType, reason, message string) error {
+	err := a.client.Status().Patch(a.ctx, a.release, patch)
+	if err != nil {
+		return err
+	}
+
+	a.eventRecorder.Event(a.release, eventType, reason, message)
+
+	return nil
+}
+
+// queueManagedPipelineRunIfNeeded marks the Release being processed as queued if the PipelineRun concurrency limit of
+// the given ReleasePlanAdmission doesn't leave a free slot for its managed PipelineRun. The slots are taken by the
+// running managed PipelineRuns and then by the queued Releases in order of creation, so the older Releases are
+// processed first. The Release is dequeued once there's a free slot for it. A boolean indicating whether the Release
+// is queued is returned.
+func (a *adapter) queueManagedPipelineRunIfNeeded(releasePlanAdmission *v1alpha1.ReleasePlanAdmission) (bool, error) {
+	patch := client.MergeFrom(a.release.DeepCopy())
+
+	limit := releasePlanAdmission.Spec.PipelineConcurrencyLimit
+	if limit > 0 {
+		pipelineRuns, err := a.loader.GetReleasePlanAdmissionPipelineRuns(a.ctx, a.client, releasePlanAdmission)
+		if err != nil {
+			return false, err
+		}
+
+		queue := fmt.Sprintf("%s%c%s", releasePlanAdmission.Namespace, types.Separator, releasePlanAdmission.Name)
+		queuedReleases, err := a.loader.GetQueuedReleases(a.ctx, a.client, releasePlanAdmission)
+		if err != nil {
+			return false, err
+		}
+
+		takenSlots := 0
+		for i := range pipelineRuns.Items {
+			if !pipelineRuns.Items[i].IsDone() {
+				takenSlots++
+			}
+		}
+		for i := range queuedReleases.Items {
+			if isQueuedBefore(&queuedReleases.Items[i], a.release) {
+				takenSlots++
+			}
+		}
+
+		if takenSlots >= limit {
+			if a.release.IsQueued() && a.release.Status.Queue == queue {
+				return true, nil
+			}
+
+			a.logger.Info("Queueing Release as the ReleasePlanAdmission PipelineRun concurrency limit was reached",
+				"ReleasePlanAdmission", queue, "Limit", limit)
+			a.release.MarkQueued(queue, fmt.Sprintf("Waiting for a free slot in the PipelineRun concurrency limit of %d of %s",
+				limit, queue))
+			return true, a.client.Status().Patch(a.ctx, a.release, patch)
+		}
+	}
+
+	if !a.release.IsQueued() {
+		return false, nil
+	}
+
+	a.release.MarkDequeued()
+	return false, a.client.Status().Patch(a.ctx, a.release, patch)
+}
+
+// recordPipelineRunCreatedEvent records an event in the Release being processed informing about the creation of the
+// given PipelineRun.
+func (a *adapter) recordPipelineRunCreatedEvent(pipelineType metadata.PipelineType, pipelineRun *tektonv1.PipelineRun) {
+	a.eventRecorder.Eventf(a.release, corev1.EventTypeNormal, pipelineRunCreatedEventReason,
+		"Created %s PipelineRun %s/%s", pipelineType, pipelineRun.Namespace, pipelineRun.Name)
+}
+
 // registerTenantCollectorsProcessingData adds all the Release Tenant Collectors processing information to its Status
 // and marks it as tenant collectors processing.
 func (a *adapter) registerTenantCollectorsProcessingData(releasePipelineRun *tektonv1.PipelineRun, tenantRoleBinding *rbac.RoleBinding, secretRoleBinding *rbac.RoleBinding) error {
@@ -1439,12 +2173,15 @@ func (a *adapter) registerTenantCollectorsProcessingStatus(pipelineRun *tektonv1
 
 	patch := client.MergeFrom(a.release.DeepCopy())
 
-	condition := pipelineRun.Status.GetCondition(apis.ConditionSucceeded)
-	if condition.IsTrue() {
+	if tekton.HasPipelineRunSucceeded(pipelineRun) {
 		a.release.MarkTenantCollectorsPipelineProcessed()
 	} else {
-		a.release.MarkTenantCollectorsPipelineProcessingFailed(condition.Message)
-		a.release.MarkReleaseFailed("Release processing failed on tenant collectors pipelineRun")
+		message := tekton.GetPipelineRunFailureMessage(a.ctx, a.client, pipelineRun)
+		a.release.MarkTenantCollectorsPipelineProcessingFailed(message)
+		a.release.MarkReleaseFailed(fmt.Sprintf("Release processing failed on tenant collectors pipelineRun: %s", message))
+
+		return a.patchStatusAndRecordEvent(patch, corev1.EventTypeWarning, pipelineRunFailedEventReason,
+			fmt.Sprintf("%s PipelineRun %s/%s failed: %s", metadata.TenantCollectorsPipelineType, pipelineRun.Namespace, pipelineRun.Name, message))
 	}
 
 	return a.client.Status().Patch(a.ctx, a.release, patch)
@@ -1460,12 +2197,17 @@ func (a *adapter) registerTenantProcessingStatus(pipelineRun *tektonv1.PipelineR
 
 	patch := client.MergeFrom(a.release.DeepCopy())
 
-	condition := pipelineRun.Status.GetCondition(apis.ConditionSucceeded)
-	if condition.IsTrue() {
+	a.release.Status.TenantProcessing.PipelineBundle = tekton.GetResolvedPipelineProvenance(pipelineRun)
+
+	if tekton.HasPipelineRunSucceeded(pipelineRun) {
 		a.release.MarkTenantPipelineProcessed()
 	} else {
-		a.release.MarkTenantPipelineProcessingFailed(condition.Message)
-		a.release.MarkReleaseFailed("Release processing failed on tenant pipelineRun")
+		message := tekton.GetPipelineRunFailureMessage(a.ctx, a.client, pipelineRun)
+		a.release.MarkTenantPipelineProcessingFailed(message)
+		a.release.MarkReleaseFailed(fmt.Sprintf("Release processing failed on tenant pipelineRun: %s", message))
+
+		return a.patchStatusAndRecordEvent(patch, corev1.EventTypeWarning, pipelineRunFailedEventReason,
+			fmt.Sprintf("%s PipelineRun %s/%s failed: %s", metadata.TenantPipelineType, pipelineRun.Namespace, pipelineRun.Name, message))
 	}
 
 	return a.client.Status().Patch(a.ctx, a.release, patch)
@@ -1481,17 +2223,59 @@ func (a *adapter) registerManagedCollectorsProcessingStatus(pipelineRun *tektonv
 
 	patch := client.MergeFrom(a.release.DeepCopy())
 
-	condition := pipelineRun.Status.GetCondition(apis.ConditionSucceeded)
-	if condition.IsTrue() {
+	if tekton.HasPipelineRunSucceeded(pipelineRun) {
 		a.release.MarkManagedCollectorsPipelineProcessed()
 	} else {
-		a.release.MarkManagedCollectorsPipelineProcessingFailed(condition.Message)
-		a.release.MarkReleaseFailed("Release processing failed on managed collectors pipelineRun")
+		message := tekton.GetPipelineRunFailureMessage(a.ctx, a.client, pipelineRun)
+		a.release.MarkManagedCollectorsPipelineProcessingFailed(message)
+		a.release.MarkReleaseFailed(fmt.Sprintf("Release processing failed on managed collectors pipelineRun: %s", message))
+
+		return a.patchStatusAndRecordEvent(patch, corev1.EventTypeWarning, pipelineRunFailedEventReason,
+			fmt.Sprintf("%s PipelineRun %s/%s failed: %s", metadata.ManagedCollectorsPipelineType, pipelineRun.Namespace, pipelineRun.Name, message))
 	}
 
 	return a.client.Status().Patch(a.ctx, a.release, patch)
 }
 
+// registerManagedProcessingArtifacts stores the results of the given managed Release PipelineRun in the artifacts of the
+// Release being processed. Results larger than the maximum artifact size are truncated. Artifacts already set in the
+// Release are kept unless the PipelineRun produced a result with the same name.
+func (a *adapter) registerManagedProcessingArtifacts(pipelineRun *tektonv1.PipelineRun) error {
+	results := tekton.GetPipelineRunResults(pipelineRun)
+	if len(results) == 0 {
+		return nil
+	}
+
+	artifacts := map[string]interface{}{}
+	if a.release.Status.Artifacts != nil && len(a.release.Status.Artifacts.Raw) > 0 {
+		err := json.Unmarshal(a.release.Status.Artifacts.Raw, &artifacts)
+		if err != nil {
+			return err
+		}
+	}
+
+	maxArtifactSize := a.getMaxArtifactSize()
+	for name, value := range results {
+		if len(value) > maxArtifactSize {
+			// The value is cut on a rune boundary so multi-byte characters are not split
+			size := maxArtifactSize
+			for size > 0 && !utf8.RuneStart(value[size]) {
+				size--
+			}
+			value = value[:size] + truncatedArtifactSuffix
+		}
+		artifacts[name] = value
+	}
+
+	raw, err := json.Marshal(artifacts)
+	if err != nil {
+		return err
+	}
+	a.release.Status.Artifacts = &runtime.RawExtension{Raw: raw}
+
+	return nil
+}
+
 // registerManagedProcessingStatus updates the status of the Release being processed by monitoring the status of the
 // associated managed Release PipelineRun and setting the appropriate state in the Release. If the PipelineRun hasn't
 // started/succeeded, no action will be taken.
@@ -1502,12 +2286,22 @@ func (a *adapter) registerManagedProcessingStatus(pipelineRun *tektonv1.Pipeline
 
 	patch := client.MergeFrom(a.release.DeepCopy())
 
-	condition := pipelineRun.Status.GetCondition(apis.ConditionSucceeded)
-	if condition.IsTrue() {
+	a.release.Status.ManagedProcessing.PipelineBundle = tekton.GetResolvedPipelineProvenance(pipelineRun)
+
+	if tekton.HasPipelineRunSucceeded(pipelineRun) {
+		err := a.registerManagedProcessingArtifacts(pipelineRun)
+		if err != nil {
+			return err
+		}
+
 		a.release.MarkManagedPipelineProcessed()
 	} else {
-		a.release.MarkManagedPipelineProcessingFailed(condition.Message)
-		a.release.MarkReleaseFailed("Release processing failed on managed pipelineRun")
+		message := tekton.GetPipelineRunFailureMessage(a.ctx, a.client, pipelineRun)
+		a.release.MarkManagedPipelineProcessingFailed(message)
+		a.release.MarkReleaseFailed(fmt.Sprintf("Release processing failed on managed pipelineRun: %s", message))
+
+		return a.patchStatusAndRecordEvent(patch, corev1.EventTypeWarning, pipelineRunFailedEventReason,
+			fmt.Sprintf("%s PipelineRun %s/%s failed: %s", metadata.ManagedPipelineType, pipelineRun.Namespace, pipelineRun.Name, message))
 	}
 
 	return a.client.Status().Patch(a.ctx, a.release, patch)
@@ -1523,17 +2317,77 @@ func (a *adapter) registerFinalProcessingStatus(pipelineRun *tektonv1.PipelineRu
 
 	patch := client.MergeFrom(a.release.DeepCopy())
 
-	condition := pipelineRun.Status.GetCondition(apis.ConditionSucceeded)
-	if condition.IsTrue() {
+	a.release.Status.FinalProcessing.PipelineBundle = tekton.GetResolvedPipelineProvenance(pipelineRun)
+
+	if tekton.HasPipelineRunSucceeded(pipelineRun) {
 		a.release.MarkFinalPipelineProcessed()
 	} else {
-		a.release.MarkFinalPipelineProcessingFailed(condition.Message)
-		a.release.MarkReleaseFailed("Release processing failed on final pipelineRun")
+		message := tekton.GetPipelineRunFailureMessage(a.ctx, a.client, pipelineRun)
+		a.release.MarkFinalPipelineProcessingFailed(message)
+		a.release.MarkReleaseFailed(fmt.Sprintf("Release processing failed on final pipelineRun: %s", message))
+
+		return a.patchStatusAndRecordEvent(patch, corev1.EventTypeWarning, pipelineRunFailedEventReason,
+			fmt.Sprintf("%s PipelineRun %s/%s failed: %s", metadata.FinalPipelineType, pipelineRun.Namespace, pipelineRun.Name, message))
 	}
 
 	return a.client.Status().Patch(a.ctx, a.release, patch)
 }
 
+// removeRetryAnnotation removes the retry annotation from the Release being processed.
+func (a *adapter) removeRetryAnnotation() error {
+	patch := client.MergeFrom(a.release.DeepCopy())
+	delete(a.release.Annotations, metadata.RetryAnnotation)
+	return a.client.Patch(a.ctx, a.release, patch)
+}
+
+// retryManagedPipelineRun recreates the given failed managed PipelineRun if the ReleasePlanAdmission allows more retries
+// than the ones already performed. The release finalizer is removed from the failed PipelineRun so it doesn't block its
+// deletion and the new PipelineRun is registered in the Release status. It returns whether the PipelineRun was retried.
+func (a *adapter) retryManagedPipelineRun(failedPipelineRun *tektonv1.PipelineRun) (bool, error) {
+	resources, err := a.loader.GetProcessingResources(a.ctx, a.client, a.release)
+	if err != nil {
+		if errors.IsNotFound(err) || stderrors.Is(err, loader.ErrReleasePlanHasNoTarget) {
+			return false, nil
+		}
+		return false, err
+	}
+
+	if resources.ReleasePlanAdmission.Spec.Pipeline == nil ||
+		a.release.Status.ManagedProcessing.Retries >= resources.ReleasePlanAdmission.Spec.Retries {
+		return false, nil
+	}
+
+	if controllerutil.ContainsFinalizer(failedPipelineRun, metadata.ReleaseFinalizer) {
+		patch := client.MergeFrom(failedPipelineRun.DeepCopy())
+		controllerutil.RemoveFinalizer(failedPipelineRun, metadata.ReleaseFinalizer)
+		err = a.client.Patch(a.ctx, failedPipelineRun, patch)
+		if err != nil && !errors.IsNotFound(err) {
+			return false, err
+		}
+	}
+
+	pipelineRun, err := a.createManagedPipelineRun(resources)
+	if err != nil {
+		if utils.IsPipelineRunBuildError(err) {
+			// The PipelineRun can't be recreated, so the original failure is registered instead
+			return false, nil
+		}
+		return false, err
+	}
+
+	a.logger.Info(fmt.Sprintf("Retried %s Release PipelineRun", metadata.ManagedPipelineType),
+		"PipelineRun.Name", pipelineRun.Name, "PipelineRun.Namespace", pipelineRun.Namespace,
+		"FailedPipelineRun.Name", failedPipelineRun.Name)
+	a.recordPipelineRunCreatedEvent(metadata.ManagedPipelineType, pipelineRun)
+
+	patch := client.MergeFrom(a.release.DeepCopy())
+	a.release.Status.ManagedProcessing.Retries++
+	a.release.Status.ManagedProcessing.PipelineRun = fmt.Sprintf("%s%c%s",
+		pipelineRun.Namespace, types.Separator, pipelineRun.Name)
+
+	return true, a.client.Status().Patch(a.ctx, a.release, patch)
+}
+
 // validateApplication will ensure that the same Application is used in both the Snapshot and the ReleasePlan. If the
 // resources reference different Applications, the Release will be marked as invalid.
 func (a *adapter) validateApplication() *controller.ValidationResult {
@@ -1610,6 +2464,37 @@ func (a *adapter) validateAuthor() *controller.ValidationResult {
 	return &controller.ValidationResult{Valid: true}
 }
 
+// validateEnterpriseContractConfigMap checks that the Enterprise Contract ConfigMap, if configured, defines all the keys
+// required by the managed Pipeline.
+func (a *adapter) validateEnterpriseContractConfigMap() *controller.ValidationResult {
+	releasePlan, err := a.loader.GetReleasePlan(a.ctx, a.client, a.release)
+	if err != nil {
+		return a.validationError(err)
+	}
+
+	if releasePlan.Spec.Target == "" {
+		return &controller.ValidationResult{Valid: true}
+	}
+
+	configMap, err := a.loader.GetEnterpriseContractConfigMap(a.ctx, a.client)
+	if err != nil {
+		return a.validationError(err)
+	}
+
+	if configMap == nil {
+		return &controller.ValidationResult{Valid: true}
+	}
+
+	for _, key := range utils.EnterpriseContractConfigMapKeys {
+		if _, err := utils.GetConfigMapValue(configMap, key); err != nil {
+			a.release.MarkValidationFailed(err.Error())
+			return &controller.ValidationResult{Valid: false}
+		}
+	}
+
+	return &controller.ValidationResult{Valid: true}
+}
+
 // validateProcessingResources will ensure that all the resources needed to process the Release exist.
 func (a *adapter) validateProcessingResources() *controller.ValidationResult {
 	releasePlan, err := a.loader.GetReleasePlan(a.ctx, a.client, a.release)
@@ -1635,7 +2520,9 @@ func (a *adapter) validateProcessingResources() *controller.ValidationResult {
 	return &controller.ValidationResult{Valid: true}
 }
 
-// validatePipelineSource checks that the Release PipelineRun ref passes the checks from the ReleaseServiceConfig.
+// validatePipelineSource checks that the Release PipelineRun refs are not ambiguous and pass the checks from the
+// ReleaseServiceConfig. The rest of the PipelineRef checks are performed at admission time, so Releases using refs
+// admitted before those checks existed are not failed.
 func (a *adapter) validatePipelineSource() *controller.ValidationResult {
 	pipelineRef := utils.PipelineRef{}
 	releasePlan, err := a.loader.GetReleasePlan(a.ctx, a.client, a.release)
@@ -1645,6 +2532,10 @@ func (a *adapter) validatePipelineSource() *controller.ValidationResult {
 
 	if releasePlan.Spec.TenantPipeline != nil {
 		pipelineRef = releasePlan.Spec.TenantPipeline.PipelineRef
+		if err := pipelineRef.ValidateUnambiguous(); err != nil {
+			a.release.MarkValidationFailed(err.Error())
+			return &controller.ValidationResult{Valid: false}
+		}
 		if !a.releaseServiceConfig.Spec.Debug && pipelineRef.IsClusterScoped() {
 			a.release.MarkValidationFailed("tried using debug only options while debug mode is disabled in the ReleaseServiceConfig")
 			return &controller.ValidationResult{Valid: false}
@@ -1653,6 +2544,10 @@ func (a *adapter) validatePipelineSource() *controller.ValidationResult {
 
 	if releasePlan.Spec.FinalPipeline != nil {
 		pipelineRef = releasePlan.Spec.FinalPipeline.PipelineRef
+		if err := pipelineRef.ValidateUnambiguous(); err != nil {
+			a.release.MarkValidationFailed(err.Error())
+			return &controller.ValidationResult{Valid: false}
+		}
 		if !a.releaseServiceConfig.Spec.Debug && pipelineRef.IsClusterScoped() {
 			a.release.MarkValidationFailed("tried using debug only options while debug mode is disabled in the ReleaseServiceConfig")
 			return &controller.ValidationResult{Valid: false}
@@ -1667,6 +2562,10 @@ func (a *adapter) validatePipelineSource() *controller.ValidationResult {
 
 		if releasePlanAdmission.Spec.Pipeline != nil {
 			pipelineRef = releasePlanAdmission.Spec.Pipeline.PipelineRef
+			if err := pipelineRef.ValidateUnambiguous(); err != nil {
+				a.release.MarkValidationFailed(err.Error())
+				return &controller.ValidationResult{Valid: false}
+			}
 			if !a.releaseServiceConfig.Spec.Debug && pipelineRef.IsClusterScoped() {
 				a.release.MarkValidationFailed("tried using debug only options while debug mode is disabled in the ReleaseServiceConfig")
 				return &controller.ValidationResult{Valid: false}
@@ -1703,7 +2602,10 @@ func (a *adapter) validatePipelineDefined() *controller.ValidationResult {
 		}
 		releasePlanAdmission, err := a.loader.GetActiveReleasePlanAdmissionFromRelease(a.ctx, a.client, a.release)
 		if err != nil {
-			if errors.IsNotFound(err) || strings.Contains(err.Error(), "with auto-release label set to false") ||
+			if errors.IsNotFound(err) || stderrors.Is(err, loader.ErrReleasePlanAdmissionNotFound) {
+				return a.waitForReleasePlanAdmission(err)
+			}
+			if strings.Contains(err.Error(), "with auto-release label set to false") ||
 				strings.Contains(err.Error(), "Origin of the releasePlanAdmission") {
 				a.release.MarkValidationFailed(err.Error())
 				return &controller.ValidationResult{Valid: false}
@@ -1730,3 +2632,32 @@ func (a *adapter) validationError(err error) *controller.ValidationResult {
 	}
 	return &controller.ValidationResult{Err: err}
 }
+
+// waitForReleasePlanAdmission marks the Release as missing its ReleasePlanAdmission so its validation is retried, as the
+// ReleasePlanAdmission is often created shortly after the Release when both are synced from git. Once the grace period
+// since the Release creation expires, the Release validation fails instead.
+func (a *adapter) waitForReleasePlanAdmission(err error) *controller.ValidationResult {
+	gracePeriod := a.pipelineRunConfig.MissingReleasePlanAdmissionGracePeriod
+	if a.release.IsValid() || time.Since(a.release.CreationTimestamp.Time) >= gracePeriod {
+		a.release.MarkValidationFailed(err.Error())
+		return &controller.ValidationResult{Valid: false}
+	}
+
+	a.release.MarkReleasePlanAdmissionMissing(err.Error())
+	return &controller.ValidationResult{Valid: false}
+}
+
+// isQueuedBefore checks whether the given queued Release is ahead of the other Release in its queue. Releases are
+// ordered by creation time and then by namespace and name, so two Releases created at the same time don't wait for
+// each other.
+func isQueuedBefore(queuedRelease, release *v1alpha1.Release) bool {
+	if queuedRelease.UID == release.UID || !queuedRelease.IsQueued() || queuedRelease.HasReleaseFinished() {
+		return false
+	}
+
+	if !queuedRelease.CreationTimestamp.Equal(&release.CreationTimestamp) {
+		return queuedRelease.CreationTimestamp.Before(&release.CreationTimestamp)
+	}
+
+	return queuedRelease.Namespace+"/"+queuedRelease.Name < release.Namespace+"/"+release.Name
+}
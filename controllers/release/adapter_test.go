@@ -38,16 +38,20 @@ import (
 	tektonv1 "github.com/tektoncd/pipeline/pkg/apis/pipeline/v1"
 	corev1 "k8s.io/api/core/v1"
 	rbac "k8s.io/api/rbac/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
 
 	ecapiv1alpha1 "github.com/conforma/crds/api/v1alpha1"
 	applicationapiv1alpha1 "github.com/konflux-ci/application-api/api/v1alpha1"
 
 	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
 var _ = Describe("Release adapter", Ordered, func() {
@@ -60,10 +64,12 @@ var _ = Describe("Release adapter", Ordered, func() {
 		component                   *applicationapiv1alpha1.Component
 		enterpriseContractConfigMap *corev1.ConfigMap
 		enterpriseContractPolicy    *ecapiv1alpha1.EnterpriseContractPolicy
+		pipelineRunConfig           tektonutils.PipelineRunConfig
 		releasePlan                 *v1alpha1.ReleasePlan
 		releasePlanAdmission        *v1alpha1.ReleasePlanAdmission
 		releaseServiceConfig        *v1alpha1.ReleaseServiceConfig
 		roleBinding                 *rbac.RoleBinding
+		serviceAccount              *corev1.ServiceAccount
 		snapshot                    *applicationapiv1alpha1.Snapshot
 	)
 
@@ -72,15 +78,17 @@ var _ = Describe("Release adapter", Ordered, func() {
 	})
 
 	BeforeAll(func() {
-		Expect(os.Setenv("DEFAULT_RELEASE_WORKSPACE_NAME", "release-workspace")).To(Succeed())
-		Expect(os.Setenv("DEFAULT_RELEASE_WORKSPACE_SIZE", "1Gi")).To(Succeed())
+		pipelineRunConfig = tektonutils.PipelineRunConfig{
+			WorkspaceName: "release-workspace",
+			WorkspaceSize: "1Gi",
+		}
 
 		createResources()
 	})
 
 	When("newAdapter is called", func() {
 		It("creates and return a new adapter", func() {
-			Expect(reflect.TypeOf(newAdapter(ctx, k8sClient, nil, loader.NewLoader(), &ctrl.Log))).To(Equal(reflect.TypeOf(&adapter{})))
+			Expect(reflect.TypeOf(newAdapter(ctx, k8sClient, k8sClient, record.NewFakeRecorder(10), nil, loader.NewLoader(), &ctrl.Log, pipelineRunConfig))).To(Equal(reflect.TypeOf(&adapter{})))
 		})
 	})
 
@@ -296,6 +304,255 @@ var _ = Describe("Release adapter", Ordered, func() {
 			Expect(err).NotTo(HaveOccurred())
 			Expect(adapter.release.HasReleaseFinished()).To(BeTrue())
 		})
+
+		It("should record a Released event only once", func() {
+			adapter.release.MarkFinalPipelineProcessing()
+			adapter.release.MarkFinalPipelineProcessed()
+
+			eventRecorder := adapter.eventRecorder.(*record.FakeRecorder)
+			_, err := adapter.EnsureReleaseIsCompleted()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(eventRecorder.Events).To(Receive(HavePrefix("Normal " + releasedEventReason)))
+
+			_, err = adapter.EnsureReleaseIsCompleted()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(eventRecorder.Events).NotTo(Receive())
+		})
+	})
+
+	When("EnsureReleaseProcessingDeadlineIsEnforced is called", func() {
+		var adapter *adapter
+		var newReleasePlanAdmission *v1alpha1.ReleasePlanAdmission
+
+		AfterEach(func() {
+			_ = adapter.client.Delete(ctx, adapter.release)
+		})
+
+		BeforeEach(func() {
+			adapter = createReleaseAndAdapter()
+			adapter.release.MarkReleasing("")
+			adapter.release.Status.StartTime = &metav1.Time{Time: time.Now().Add(-time.Hour)}
+
+			newReleasePlanAdmission = releasePlanAdmission.DeepCopy()
+			newReleasePlanAdmission.Spec.ProcessingDeadline = &metav1.Duration{Duration: time.Minute}
+			adapter.ctx = toolkit.GetMockedContext(ctx, []toolkit.MockData{
+				{
+					ContextKey: loader.ReleasePlanAdmissionContextKey,
+					Resource:   newReleasePlanAdmission,
+				},
+			})
+		})
+
+		It("should continue if the Release is not releasing", func() {
+			adapter.release.Status.Conditions = nil
+
+			result, err := adapter.EnsureReleaseProcessingDeadlineIsEnforced()
+			Expect(!result.RequeueRequest && !result.CancelRequest).To(BeTrue())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(adapter.release.IsFailed()).To(BeFalse())
+		})
+
+		It("should continue if no deadline is set", func() {
+			newReleasePlanAdmission.Spec.ProcessingDeadline = nil
+
+			result, err := adapter.EnsureReleaseProcessingDeadlineIsEnforced()
+			Expect(!result.RequeueRequest && !result.CancelRequest).To(BeTrue())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(adapter.release.IsFailed()).To(BeFalse())
+		})
+
+		It("should requeue the Release until the deadline is reached", func() {
+			newReleasePlanAdmission.Spec.ProcessingDeadline = &metav1.Duration{Duration: 2 * time.Hour}
+
+			result, err := adapter.EnsureReleaseProcessingDeadlineIsEnforced()
+			Expect(result.RequeueRequest && !result.CancelRequest).To(BeTrue())
+			Expect(result.RequeueDelay).To(BeNumerically("~", time.Hour, time.Minute))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(adapter.release.IsFailed()).To(BeFalse())
+		})
+
+		It("should measure the deadline from the last retry if the Release timed out and was retried", func() {
+			adapter.release.MarkManagedPipelineProcessing()
+			adapter.release.MarkManagedPipelineProcessingFailed("")
+			adapter.release.MarkReleaseFailedWithReason(v1alpha1.TimeoutReason, "")
+			adapter.release.MarkRetried()
+			adapter.release.MarkManagedPipelineProcessing()
+
+			result, err := adapter.EnsureReleaseProcessingDeadlineIsEnforced()
+			Expect(result.RequeueRequest && !result.CancelRequest).To(BeTrue())
+			Expect(result.RequeueDelay).To(BeNumerically("~", time.Minute, 5*time.Second))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(adapter.release.IsFailed()).To(BeFalse())
+		})
+
+		It("should use the deadline from the operator configuration if the ReleasePlanAdmission doesn't set one", func() {
+			newReleasePlanAdmission.Spec.ProcessingDeadline = nil
+			adapter.pipelineRunConfig.ProcessingDeadline = time.Minute
+
+			result, err := adapter.EnsureReleaseProcessingDeadlineIsEnforced()
+			Expect(!result.RequeueRequest && !result.CancelRequest).To(BeTrue())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(adapter.release.IsFailed()).To(BeTrue())
+		})
+
+		It("should use the deadline from the operator configuration if there is no ReleasePlanAdmission", func() {
+			adapter.pipelineRunConfig.ProcessingDeadline = time.Minute
+			adapter.ctx = toolkit.GetMockedContext(ctx, []toolkit.MockData{
+				{
+					ContextKey: loader.ReleasePlanAdmissionContextKey,
+					Err:        loader.ErrReleasePlanHasNoTarget,
+				},
+			})
+
+			result, err := adapter.EnsureReleaseProcessingDeadlineIsEnforced()
+			Expect(!result.RequeueRequest && !result.CancelRequest).To(BeTrue())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(adapter.release.IsFailed()).To(BeTrue())
+		})
+
+		It("should requeue with error if fetching the ReleasePlanAdmission returns an error besides not found", func() {
+			adapter.pipelineRunConfig.ProcessingDeadline = time.Minute
+			adapter.ctx = toolkit.GetMockedContext(ctx, []toolkit.MockData{
+				{
+					ContextKey: loader.ReleasePlanAdmissionContextKey,
+					Err:        fmt.Errorf("some error"),
+				},
+			})
+
+			result, err := adapter.EnsureReleaseProcessingDeadlineIsEnforced()
+			Expect(result.RequeueRequest && !result.CancelRequest).To(BeTrue())
+			Expect(err).To(HaveOccurred())
+			Expect(adapter.release.IsFailed()).To(BeFalse())
+		})
+
+		It("should cancel the PipelineRun and mark the Release as failed once the deadline is reached", func() {
+			adapter.release.MarkManagedPipelineProcessing()
+
+			pipelineRun := &tektonv1.PipelineRun{
+				ObjectMeta: metav1.ObjectMeta{
+					GenerateName: "pipeline-run-",
+					Namespace:    "default",
+				},
+			}
+			Expect(k8sClient.Create(ctx, pipelineRun)).To(Succeed())
+			adapter.ctx = toolkit.GetMockedContext(ctx, []toolkit.MockData{
+				{
+					ContextKey: loader.ReleasePlanAdmissionContextKey,
+					Resource:   newReleasePlanAdmission,
+				},
+				{
+					ContextKey: loader.ReleasePipelineRunContextKey,
+					Resource:   pipelineRun,
+				},
+			})
+
+			result, err := adapter.EnsureReleaseProcessingDeadlineIsEnforced()
+			Expect(!result.RequeueRequest && !result.CancelRequest).To(BeTrue())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(adapter.release.HasManagedPipelineProcessingFinished()).To(BeTrue())
+			Expect(adapter.release.IsFailed()).To(BeTrue())
+
+			condition := meta.FindStatusCondition(adapter.release.Status.Conditions, "Released")
+			Expect(condition).NotTo(BeNil())
+			Expect(condition.Reason).To(Equal(v1alpha1.TimeoutReason.String()))
+
+			eventRecorder := adapter.eventRecorder.(*record.FakeRecorder)
+			Expect(eventRecorder.Events).To(Receive(ContainSubstring(v1alpha1.TimeoutReason.String())))
+
+			Expect(k8sClient.Get(ctx, types.NamespacedName{Name: pipelineRun.Name, Namespace: pipelineRun.Namespace}, pipelineRun)).To(Succeed())
+			Expect(pipelineRun.IsCancelled()).To(BeTrue())
+			Expect(k8sClient.Delete(ctx, pipelineRun)).To(Succeed())
+		})
+
+		It("should not patch a PipelineRun that is already cancelled", func() {
+			adapter.release.MarkManagedPipelineProcessing()
+
+			pipelineRun := &tektonv1.PipelineRun{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "cancelled-pipeline-run",
+					Namespace: "default",
+				},
+				Spec: tektonv1.PipelineRunSpec{
+					Status: tektonv1.PipelineRunSpecStatusCancelled,
+				},
+			}
+			adapter.ctx = toolkit.GetMockedContext(ctx, []toolkit.MockData{
+				{
+					ContextKey: loader.ReleasePlanAdmissionContextKey,
+					Resource:   newReleasePlanAdmission,
+				},
+				{
+					ContextKey: loader.ReleasePipelineRunContextKey,
+					Resource:   pipelineRun,
+				},
+			})
+
+			result, err := adapter.EnsureReleaseProcessingDeadlineIsEnforced()
+			Expect(!result.RequeueRequest && !result.CancelRequest).To(BeTrue())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(adapter.release.IsFailed()).To(BeTrue())
+		})
+
+		It("should requeue without failing the Release if the PipelineRun finished before being cancelled", func() {
+			adapter.release.MarkManagedPipelineProcessing()
+
+			pipelineRun := &tektonv1.PipelineRun{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "finished-pipeline-run",
+					Namespace: "default",
+				},
+			}
+			pipelineRun.Status.MarkSucceeded("", "")
+			adapter.ctx = toolkit.GetMockedContext(ctx, []toolkit.MockData{
+				{
+					ContextKey: loader.ReleasePlanAdmissionContextKey,
+					Resource:   newReleasePlanAdmission,
+				},
+				{
+					ContextKey: loader.ReleasePipelineRunContextKey,
+					Resource:   pipelineRun,
+				},
+			})
+
+			result, err := adapter.EnsureReleaseProcessingDeadlineIsEnforced()
+			Expect(result.RequeueRequest && !result.CancelRequest).To(BeTrue())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(pipelineRun.IsCancelled()).To(BeFalse())
+			Expect(adapter.release.IsFailed()).To(BeFalse())
+		})
+
+		It("should fail to cancel a PipelineRun that changed since it was read", func() {
+			adapter.release.MarkManagedPipelineProcessing()
+
+			pipelineRun := &tektonv1.PipelineRun{
+				ObjectMeta: metav1.ObjectMeta{
+					GenerateName: "pipeline-run-",
+					Namespace:    "default",
+				},
+			}
+			Expect(k8sClient.Create(ctx, pipelineRun)).To(Succeed())
+			stalePipelineRun := pipelineRun.DeepCopy()
+			pipelineRun.Annotations = map[string]string{"foo": "bar"}
+			Expect(k8sClient.Update(ctx, pipelineRun)).To(Succeed())
+			adapter.ctx = toolkit.GetMockedContext(ctx, []toolkit.MockData{
+				{
+					ContextKey: loader.ReleasePlanAdmissionContextKey,
+					Resource:   newReleasePlanAdmission,
+				},
+				{
+					ContextKey: loader.ReleasePipelineRunContextKey,
+					Resource:   stalePipelineRun,
+				},
+			})
+
+			result, err := adapter.EnsureReleaseProcessingDeadlineIsEnforced()
+			Expect(result.RequeueRequest).To(BeTrue())
+			Expect(errors.IsConflict(err)).To(BeTrue())
+
+			Expect(k8sClient.Get(ctx, types.NamespacedName{Name: pipelineRun.Name, Namespace: pipelineRun.Namespace}, pipelineRun)).To(Succeed())
+			Expect(pipelineRun.IsCancelled()).To(BeFalse())
+			Expect(k8sClient.Delete(ctx, pipelineRun)).To(Succeed())
+		})
 	})
 
 	When("EnsureReleaseIsRunning is called", func() {
@@ -335,6 +592,169 @@ var _ = Describe("Release adapter", Ordered, func() {
 		})
 	})
 
+	When("EnsureReleaseIsRetried is called", func() {
+		var adapter *adapter
+
+		AfterEach(func() {
+			_ = adapter.client.Delete(ctx, adapter.release)
+		})
+
+		BeforeEach(func() {
+			adapter = createReleaseAndAdapter()
+			adapter.releaseServiceConfig = releaseServiceConfig
+		})
+
+		markManagedPipelineFailed := func() {
+			adapter.release.MarkReleasing("")
+			adapter.release.MarkManagedPipelineProcessing()
+			adapter.release.MarkManagedPipelineProcessingFailed("")
+			adapter.release.MarkFinalPipelineProcessingSkipped()
+			adapter.release.MarkReleaseFailed("")
+		}
+
+		requestRetry := func() {
+			adapter.release.Annotations = map[string]string{
+				metadata.RetryAnnotation: "true",
+			}
+			Expect(k8sClient.Update(ctx, adapter.release)).To(Succeed())
+		}
+
+		It("should continue if the retry annotation is not set", func() {
+			markManagedPipelineFailed()
+
+			result, err := adapter.EnsureReleaseIsRetried()
+			Expect(!result.RequeueRequest && !result.CancelRequest).To(BeTrue())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(adapter.release.IsFailed()).To(BeTrue())
+			Expect(adapter.release.Status.Attempts).To(BeZero())
+		})
+
+		It("should remove the annotation without retrying if the Release succeeded", func() {
+			requestRetry()
+			adapter.release.MarkReleasing("")
+			adapter.release.MarkReleased()
+
+			result, err := adapter.EnsureReleaseIsRetried()
+			Expect(!result.RequeueRequest && !result.CancelRequest).To(BeTrue())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(adapter.release.IsReleased()).To(BeTrue())
+			Expect(adapter.release.Status.Attempts).To(BeZero())
+			Expect(adapter.release.Annotations).NotTo(HaveKey(metadata.RetryAnnotation))
+		})
+
+		It("should remove the annotation without retrying if the Release didn't fail on the managed pipelineRun", func() {
+			requestRetry()
+			adapter.release.MarkReleasing("")
+			adapter.release.MarkReleaseFailed("")
+
+			result, err := adapter.EnsureReleaseIsRetried()
+			Expect(!result.RequeueRequest && !result.CancelRequest).To(BeTrue())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(adapter.release.IsFailed()).To(BeTrue())
+			Expect(adapter.release.Status.Attempts).To(BeZero())
+			Expect(adapter.release.Annotations).NotTo(HaveKey(metadata.RetryAnnotation))
+		})
+
+		It("should requeue if the failed PipelineRun is still executing", func() {
+			requestRetry()
+			markManagedPipelineFailed()
+
+			adapter.ctx = toolkit.GetMockedContext(ctx, []toolkit.MockData{
+				{
+					ContextKey: loader.ReleasePipelineRunContextKey,
+					Resource: &tektonv1.PipelineRun{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:      "pipeline-run",
+							Namespace: "default",
+						},
+					},
+				},
+			})
+
+			result, err := adapter.EnsureReleaseIsRetried()
+			Expect(result.RequeueRequest && !result.CancelRequest).To(BeTrue())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(adapter.release.IsFailed()).To(BeTrue())
+			Expect(adapter.release.Annotations).To(HaveKey(metadata.RetryAnnotation))
+		})
+
+		It("should remove the annotation without retrying if the ReleasePlan has no target", func() {
+			requestRetry()
+			markManagedPipelineFailed()
+
+			adapter.ctx = toolkit.GetMockedContext(ctx, []toolkit.MockData{
+				{
+					ContextKey: loader.ReleasePipelineRunContextKey,
+					Resource:   nil,
+				},
+				{
+					ContextKey: loader.ProcessingResourcesContextKey,
+					Err:        loader.ErrReleasePlanHasNoTarget,
+				},
+			})
+
+			result, err := adapter.EnsureReleaseIsRetried()
+			Expect(!result.RequeueRequest && !result.CancelRequest).To(BeTrue())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(adapter.release.IsFailed()).To(BeTrue())
+			Expect(adapter.release.Status.Attempts).To(BeZero())
+			Expect(adapter.release.Annotations).NotTo(HaveKey(metadata.RetryAnnotation))
+		})
+
+		It("should recreate the managed PipelineRun and mark the Release as releasing", func() {
+			requestRetry()
+
+			failedPipelineRun := &tektonv1.PipelineRun{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:       "failed-pipeline-run",
+					Namespace:  "default",
+					Finalizers: []string{metadata.ReleaseFinalizer},
+				},
+			}
+			Expect(k8sClient.Create(ctx, failedPipelineRun)).To(Succeed())
+			failedPipelineRun.Status.MarkFailed("", "")
+
+			markManagedPipelineFailed()
+			adapter.release.Status.ManagedProcessing.PipelineRun = "default/failed-pipeline-run"
+
+			adapter.ctx = toolkit.GetMockedContext(ctx, []toolkit.MockData{
+				{
+					ContextKey: loader.ReleasePipelineRunContextKey,
+					Resource:   failedPipelineRun,
+				},
+				{
+					ContextKey: loader.ProcessingResourcesContextKey,
+					Resource: &loader.ProcessingResources{
+						EnterpriseContractConfigMap: enterpriseContractConfigMap,
+						EnterpriseContractPolicy:    enterpriseContractPolicy,
+						ReleasePlan:                 releasePlan,
+						ReleasePlanAdmission:        releasePlanAdmission,
+						Snapshot:                    snapshot,
+					},
+				},
+			})
+
+			result, err := adapter.EnsureReleaseIsRetried()
+			Expect(!result.RequeueRequest && !result.CancelRequest).To(BeTrue())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(adapter.release.IsFailed()).To(BeFalse())
+			Expect(adapter.release.IsReleasing()).To(BeTrue())
+			Expect(adapter.release.IsManagedPipelineProcessing()).To(BeTrue())
+			Expect(adapter.release.HasFinalPipelineProcessingFinished()).To(BeFalse())
+			Expect(adapter.release.Status.Attempts).To(Equal(1))
+			Expect(adapter.release.Status.ManagedProcessing.PipelineRun).NotTo(BeEmpty())
+			Expect(adapter.release.Status.ManagedProcessing.PipelineRun).NotTo(Equal("default/failed-pipeline-run"))
+			Expect(adapter.release.Annotations).NotTo(HaveKey(metadata.RetryAnnotation))
+
+			Expect(k8sClient.Get(ctx, types.NamespacedName{
+				Name:      failedPipelineRun.Name,
+				Namespace: failedPipelineRun.Namespace,
+			}, failedPipelineRun)).To(Succeed())
+			Expect(failedPipelineRun.Finalizers).To(BeEmpty())
+			Expect(k8sClient.Delete(ctx, failedPipelineRun)).To(Succeed())
+		})
+	})
+
 	When("EnsureFinalPipelineIsProcessed is called", func() {
 		var adapter *adapter
 
@@ -894,7 +1314,7 @@ var _ = Describe("Release adapter", Ordered, func() {
 			adapter.ctx = toolkit.GetMockedContext(ctx, []toolkit.MockData{
 				{
 					ContextKey: loader.ProcessingResourcesContextKey,
-					Err:        fmt.Errorf("no ReleasePlanAdmissions can be found"),
+					Err:        loader.ErrReleasePlanHasNoTarget,
 				},
 				{
 					ContextKey: loader.RoleBindingContextKey,
@@ -944,8 +1364,65 @@ var _ = Describe("Release adapter", Ordered, func() {
 			result, err := adapter.EnsureManagedPipelineIsProcessed()
 			Expect(!result.RequeueRequest && !result.CancelRequest).To(BeTrue())
 			Expect(err).NotTo(HaveOccurred())
-			Expect(adapter.release.IsManagedPipelineProcessing()).To(BeFalse())
-			Expect(adapter.release.IsManagedPipelineSkipped()).To(BeTrue())
+			Expect(adapter.release.IsManagedPipelineProcessing()).To(BeFalse())
+			Expect(adapter.release.IsManagedPipelineSkipped()).To(BeTrue())
+		})
+
+		It("should mark the Release as failed if the ServiceAccount doesn't exist in the managed namespace", func() {
+			adapter.ctx = toolkit.GetMockedContext(ctx, []toolkit.MockData{
+				{
+					ContextKey: loader.ServiceAccountContextKey,
+					Err:        errors.NewNotFound(schema.GroupResource{}, ""),
+				},
+			})
+			adapter.release.MarkTenantPipelineProcessingSkipped()
+
+			result, err := adapter.EnsureManagedPipelineIsProcessed()
+			Expect(!result.RequeueRequest && !result.CancelRequest).To(BeTrue())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(adapter.release.HasManagedPipelineProcessingFinished()).To(BeTrue())
+			Expect(adapter.release.IsFailed()).To(BeTrue())
+			Expect(adapter.release.Status.Conditions).To(ContainElement(HaveField("Message",
+				ContainSubstring("the ServiceAccount service-account doesn't exist in the default namespace"))))
+
+			eventRecorder := adapter.eventRecorder.(*record.FakeRecorder)
+			Expect(eventRecorder.Events).To(Receive(And(
+				HavePrefix("Warning "+pipelineRunCreationFailedEventReason),
+				ContainSubstring("the ServiceAccount service-account doesn't exist in the default namespace"),
+			)))
+		})
+
+		It("should mark the Release as failed if the signing Secret doesn't exist in the managed namespace", func() {
+			newReleasePlanAdmission := releasePlanAdmission.DeepCopy()
+			newReleasePlanAdmission.Spec.Pipeline.SigningSecretWorkspace = &tektonutils.SecretWorkspace{
+				Name:       "signing-keys",
+				SecretName: "signing-secret",
+			}
+			adapter.ctx = toolkit.GetMockedContext(ctx, []toolkit.MockData{
+				{
+					ContextKey: loader.ProcessingResourcesContextKey,
+					Resource: &loader.ProcessingResources{
+						EnterpriseContractConfigMap: enterpriseContractConfigMap,
+						EnterpriseContractPolicy:    enterpriseContractPolicy,
+						ReleasePlan:                 releasePlan,
+						ReleasePlanAdmission:        newReleasePlanAdmission,
+						Snapshot:                    snapshot,
+					},
+				},
+				{
+					ContextKey: loader.SecretContextKey,
+					Err:        errors.NewNotFound(schema.GroupResource{}, ""),
+				},
+			})
+			adapter.release.MarkTenantPipelineProcessingSkipped()
+
+			result, err := adapter.EnsureManagedPipelineIsProcessed()
+			Expect(!result.RequeueRequest && !result.CancelRequest).To(BeTrue())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(adapter.release.HasManagedPipelineProcessingFinished()).To(BeTrue())
+			Expect(adapter.release.IsFailed()).To(BeTrue())
+			Expect(adapter.release.Status.Conditions).To(ContainElement(HaveField("Message",
+				ContainSubstring("the Secret signing-secret doesn't exist in the default namespace"))))
 		})
 
 		It("should continue if the PipelineRun exists and the release managed pipeline processing has started", func() {
@@ -1044,6 +1521,12 @@ var _ = Describe("Release adapter", Ordered, func() {
 			Expect(!result.RequeueRequest && !result.CancelRequest).To(BeTrue())
 			Expect(err).NotTo(HaveOccurred())
 
+			eventRecorder := adapter.eventRecorder.(*record.FakeRecorder)
+			Expect(eventRecorder.Events).To(Receive(And(
+				HavePrefix("Normal "+pipelineRunCreatedEventReason),
+				ContainSubstring(adapter.release.Status.ManagedProcessing.PipelineRun),
+			)))
+
 			// Reset MockedContext so that the RoleBinding that was just created can be fetched
 			adapter.ctx = toolkit.GetMockedContext(ctx, []toolkit.MockData{
 				{
@@ -1639,6 +2122,34 @@ var _ = Describe("Release adapter", Ordered, func() {
 			Expect(err).NotTo(HaveOccurred())
 			Expect(adapter.client.Delete(adapter.ctx, pipelineRun)).To(Succeed())
 		})
+
+		It("should mark the tenant pipeline processing and the Release as failed if the PipelineRun can't be built", func() {
+			adapter.pipelineRunConfig.WorkspaceSize = "invalid"
+
+			newReleasePlan := releasePlan.DeepCopy()
+			newReleasePlan.Spec.TenantPipeline = &tektonutils.ParameterizedPipeline{
+				Pipeline: tektonutils.Pipeline{
+					PipelineRef: *tektonutils.NewGitPipelineRef("my-url", "my-revision", "my-path"),
+				},
+			}
+			adapter.ctx = toolkit.GetMockedContext(ctx, []toolkit.MockData{
+				{
+					ContextKey: loader.ReleasePlanContextKey,
+					Resource:   newReleasePlan,
+				},
+				{
+					ContextKey: loader.SnapshotContextKey,
+					Resource:   snapshot,
+				},
+			})
+
+			adapter.release.MarkManagedCollectorsPipelineProcessingSkipped()
+			result, err := adapter.EnsureTenantPipelineIsProcessed()
+			Expect(!result.RequeueRequest && !result.CancelRequest).To(BeTrue())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(adapter.release.HasTenantPipelineProcessingFinished()).To(BeTrue())
+			Expect(adapter.release.IsFailed()).To(BeTrue())
+		})
 	})
 
 	When("EnsureReleaseIsValid is called", func() {
@@ -1691,6 +2202,62 @@ var _ = Describe("Release adapter", Ordered, func() {
 			Expect(adapter.release.HasReleaseFinished()).To(BeFalse())
 		})
 
+		It("should requeue the release without failing it if its ReleasePlanAdmission is missing", func() {
+			adapter.validations = []controller.ValidationFunction{
+				func() *controller.ValidationResult {
+					adapter.release.MarkReleasePlanAdmissionMissing("no ReleasePlanAdmission found")
+					return &controller.ValidationResult{Valid: false}
+				},
+			}
+
+			result, err := adapter.EnsureReleaseIsValid()
+			Expect(result.RequeueRequest && !result.CancelRequest).To(BeTrue())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(adapter.release.IsReleasePlanAdmissionMissing()).To(BeTrue())
+			Expect(adapter.release.HasReleaseFinished()).To(BeFalse())
+		})
+
+		It("should record a Validated event only once", func() {
+			adapter.validations = []controller.ValidationFunction{}
+
+			eventRecorder := adapter.eventRecorder.(*record.FakeRecorder)
+			_, err := adapter.EnsureReleaseIsValid()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(eventRecorder.Events).To(Receive(HavePrefix("Normal " + validatedEventReason)))
+
+			_, err = adapter.EnsureReleaseIsValid()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(eventRecorder.Events).NotTo(Receive())
+		})
+
+		It("should record a ValidationFailed event with the validation message if a validation fails", func() {
+			adapter.validations = []controller.ValidationFunction{
+				func() *controller.ValidationResult {
+					adapter.release.MarkValidationFailed("invalid release")
+					return &controller.ValidationResult{Valid: false}
+				},
+			}
+
+			_, err := adapter.EnsureReleaseIsValid()
+			Expect(err).NotTo(HaveOccurred())
+			eventRecorder := adapter.eventRecorder.(*record.FakeRecorder)
+			Expect(eventRecorder.Events).To(Receive(Equal("Warning " + validationFailedEventReason + " invalid release")))
+		})
+
+		It("should not record any event if its ReleasePlanAdmission is missing", func() {
+			adapter.validations = []controller.ValidationFunction{
+				func() *controller.ValidationResult {
+					adapter.release.MarkReleasePlanAdmissionMissing("no ReleasePlanAdmission found")
+					return &controller.ValidationResult{Valid: false}
+				},
+			}
+
+			_, err := adapter.EnsureReleaseIsValid()
+			Expect(err).NotTo(HaveOccurred())
+			eventRecorder := adapter.eventRecorder.(*record.FakeRecorder)
+			Expect(eventRecorder.Events).NotTo(Receive())
+		})
+
 		It("does not clear the release status", func() {
 			adapter.validations = []controller.ValidationFunction{}
 
@@ -1727,88 +2294,317 @@ var _ = Describe("Release adapter", Ordered, func() {
 			Expect(err).NotTo(HaveOccurred())
 		})
 
-		It("should track the status if the PipelineRun exists", func() {
-			adapter.release.MarkTenantPipelineProcessing()
+		It("should track the status if the PipelineRun exists", func() {
+			adapter.release.MarkTenantPipelineProcessing()
+
+			pipelineRun := &tektonv1.PipelineRun{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "pipeline-run",
+					Namespace: "default",
+				},
+			}
+			pipelineRun.Status.MarkSucceeded("", "")
+			adapter.ctx = toolkit.GetMockedContext(ctx, []toolkit.MockData{
+				{
+					ContextKey: loader.ReleasePipelineRunContextKey,
+					Resource:   pipelineRun,
+				},
+			})
+
+			result, err := adapter.EnsureTenantPipelineProcessingIsTracked()
+			Expect(!result.RequeueRequest && !result.CancelRequest).To(BeTrue())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(adapter.release.HasTenantPipelineProcessingFinished()).To(BeTrue())
+		})
+
+		It("should continue if the PipelineRun doesn't exist", func() {
+			adapter.release.MarkTenantPipelineProcessing()
+
+			result, err := adapter.EnsureTenantPipelineProcessingIsTracked()
+			Expect(!result.RequeueRequest && !result.CancelRequest).To(BeTrue())
+			Expect(err).NotTo(HaveOccurred())
+		})
+	})
+
+	When("EnsureManagedPipelineProcessingIsTracked is called", func() {
+		var adapter *adapter
+
+		AfterEach(func() {
+			_ = adapter.client.Delete(ctx, adapter.release)
+		})
+
+		BeforeEach(func() {
+			adapter = createReleaseAndAdapter()
+		})
+
+		It("should continue if the Release managed pipeline processing has not started", func() {
+			result, err := adapter.EnsureManagedPipelineProcessingIsTracked()
+			Expect(!result.RequeueRequest && !result.CancelRequest).To(BeTrue())
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("should continue if the Release managed pipeline processing has finished", func() {
+			adapter.release.MarkManagedPipelineProcessing()
+			adapter.release.MarkManagedPipelineProcessed()
+
+			result, err := adapter.EnsureManagedPipelineProcessingIsTracked()
+			Expect(!result.RequeueRequest && !result.CancelRequest).To(BeTrue())
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("should track the status if the PipelineRun exists", func() {
+			adapter.release.MarkManagedPipelineProcessing()
+
+			pipelineRun := &tektonv1.PipelineRun{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "pipeline-run",
+					Namespace: "default",
+				},
+			}
+			pipelineRun.Status.MarkSucceeded("", "")
+			adapter.ctx = toolkit.GetMockedContext(ctx, []toolkit.MockData{
+				{
+					ContextKey: loader.ReleasePipelineRunContextKey,
+					Resource:   pipelineRun,
+				},
+				{
+					ContextKey: loader.RoleBindingContextKey,
+				},
+			})
+
+			result, err := adapter.EnsureManagedPipelineProcessingIsTracked()
+			Expect(!result.RequeueRequest && !result.CancelRequest).To(BeTrue())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(adapter.release.HasManagedPipelineProcessingFinished()).To(BeTrue())
+		})
+
+		It("should mark the Release as failed if the registered PipelineRun was pruned", func() {
+			adapter.release.MarkReleasing("")
+			adapter.release.MarkManagedPipelineProcessing()
+			adapter.release.Status.ManagedProcessing.PipelineRun = "default/pruned-pipeline-run"
+
+			result, err := adapter.EnsureManagedPipelineProcessingIsTracked()
+			Expect(!result.RequeueRequest && !result.CancelRequest).To(BeTrue())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(adapter.release.HasManagedPipelineProcessingFinished()).To(BeTrue())
+			Expect(adapter.release.IsFailed()).To(BeTrue())
+
+			condition := meta.FindStatusCondition(adapter.release.Status.Conditions, "Released")
+			Expect(condition).NotTo(BeNil())
+			Expect(condition.Reason).To(Equal(v1alpha1.PipelineRunDeletedReason.String()))
+
+			eventRecorder := adapter.eventRecorder.(*record.FakeRecorder)
+			Expect(eventRecorder.Events).To(Receive(ContainSubstring(v1alpha1.PipelineRunDeletedReason.String())))
+		})
+
+		It("should mark the Release as failed if the PipelineRun is being deleted before finishing", func() {
+			adapter.release.MarkReleasing("")
+			adapter.release.MarkManagedPipelineProcessing()
+
+			pipelineRun := &tektonv1.PipelineRun{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:              "pipeline-run",
+					Namespace:         "default",
+					DeletionTimestamp: &metav1.Time{Time: time.Now()},
+				},
+			}
+			adapter.ctx = toolkit.GetMockedContext(ctx, []toolkit.MockData{
+				{
+					ContextKey: loader.ReleasePipelineRunContextKey,
+					Resource:   pipelineRun,
+				},
+			})
+
+			result, err := adapter.EnsureManagedPipelineProcessingIsTracked()
+			Expect(!result.RequeueRequest && !result.CancelRequest).To(BeTrue())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(adapter.release.IsFailed()).To(BeTrue())
+
+			condition := meta.FindStatusCondition(adapter.release.Status.Conditions, "Released")
+			Expect(condition).NotTo(BeNil())
+			Expect(condition.Reason).To(Equal(v1alpha1.PipelineRunDeletedReason.String()))
+			Expect(condition.Message).To(ContainSubstring("default/pipeline-run"))
+		})
+
+		It("should not mark the Release as failed if the registered PipelineRun still exists", func() {
+			adapter.release.MarkReleasing("")
+			adapter.release.MarkManagedPipelineProcessing()
+
+			pipelineRun := &tektonv1.PipelineRun{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "unlabeled-pipeline-run",
+					Namespace: "default",
+				},
+			}
+			Expect(k8sClient.Create(ctx, pipelineRun)).To(Succeed())
+			adapter.release.Status.ManagedProcessing.PipelineRun = "default/unlabeled-pipeline-run"
+
+			result, err := adapter.EnsureManagedPipelineProcessingIsTracked()
+			Expect(!result.RequeueRequest && !result.CancelRequest).To(BeTrue())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(adapter.release.IsFailed()).To(BeFalse())
+
+			Expect(k8sClient.Delete(ctx, pipelineRun)).To(Succeed())
+		})
+
+		It("should recreate a failed PipelineRun if the ReleasePlanAdmission allows retries", func() {
+			adapter.releaseServiceConfig = releaseServiceConfig
+			adapter.release.MarkManagedPipelineProcessing()
+
+			newReleasePlanAdmission := releasePlanAdmission.DeepCopy()
+			newReleasePlanAdmission.Spec.Retries = 2
+
+			failedPipelineRun := &tektonv1.PipelineRun{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:       "failed-pipeline-run",
+					Namespace:  "default",
+					Finalizers: []string{metadata.ReleaseFinalizer},
+				},
+			}
+			Expect(k8sClient.Create(ctx, failedPipelineRun)).To(Succeed())
+			failedPipelineRun.Status.MarkFailed("", "")
+			adapter.ctx = toolkit.GetMockedContext(ctx, []toolkit.MockData{
+				{
+					ContextKey: loader.ReleasePipelineRunContextKey,
+					Resource:   failedPipelineRun,
+				},
+				{
+					ContextKey: loader.ProcessingResourcesContextKey,
+					Resource: &loader.ProcessingResources{
+						EnterpriseContractConfigMap: enterpriseContractConfigMap,
+						EnterpriseContractPolicy:    enterpriseContractPolicy,
+						ReleasePlan:                 releasePlan,
+						ReleasePlanAdmission:        newReleasePlanAdmission,
+						Snapshot:                    snapshot,
+					},
+				},
+			})
+
+			result, err := adapter.EnsureManagedPipelineProcessingIsTracked()
+			Expect(!result.RequeueRequest && !result.CancelRequest).To(BeTrue())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(adapter.release.HasManagedPipelineProcessingFinished()).To(BeFalse())
+			Expect(adapter.release.IsFailed()).To(BeFalse())
+			Expect(adapter.release.Status.ManagedProcessing.Retries).To(Equal(1))
+			Expect(adapter.release.Status.ManagedProcessing.PipelineRun).NotTo(Equal("default/failed-pipeline-run"))
+
+			Expect(k8sClient.Get(ctx, types.NamespacedName{
+				Name:      failedPipelineRun.Name,
+				Namespace: failedPipelineRun.Namespace,
+			}, failedPipelineRun)).To(Succeed())
+			Expect(failedPipelineRun.Finalizers).To(BeEmpty())
+			Expect(k8sClient.Delete(ctx, failedPipelineRun)).To(Succeed())
+		})
+
+		It("should mark the Release as failed if the PipelineRun failed and no retries are left", func() {
+			adapter.release.MarkManagedPipelineProcessing()
+			adapter.release.Status.ManagedProcessing.Retries = 2
+			adapter.release.Status.ManagedProcessing.PipelineRun = "default/pipeline-run"
+
+			newReleasePlanAdmission := releasePlanAdmission.DeepCopy()
+			newReleasePlanAdmission.Spec.Retries = 2
+
+			pipelineRun := &tektonv1.PipelineRun{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "pipeline-run",
+					Namespace: "default",
+				},
+			}
+			pipelineRun.Status.MarkFailed("", "")
+			adapter.ctx = toolkit.GetMockedContext(ctx, []toolkit.MockData{
+				{
+					ContextKey: loader.ReleasePipelineRunContextKey,
+					Resource:   pipelineRun,
+				},
+				{
+					ContextKey: loader.ProcessingResourcesContextKey,
+					Resource: &loader.ProcessingResources{
+						ReleasePlanAdmission: newReleasePlanAdmission,
+					},
+				},
+			})
+
+			result, err := adapter.EnsureManagedPipelineProcessingIsTracked()
+			Expect(!result.RequeueRequest && !result.CancelRequest).To(BeTrue())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(adapter.release.HasManagedPipelineProcessingFinished()).To(BeTrue())
+			Expect(adapter.release.IsFailed()).To(BeTrue())
+			Expect(adapter.release.Status.ManagedProcessing.Retries).To(Equal(2))
+		})
+
+		It("should recreate a failed PipelineRun again once the Release was retried using the retry annotation", func() {
+			adapter.releaseServiceConfig = releaseServiceConfig
+			adapter.release.MarkReleasing("")
+			adapter.release.MarkManagedPipelineProcessing()
+			adapter.release.Status.ManagedProcessing.Retries = 2
+			adapter.release.MarkManagedPipelineProcessingFailed("")
+			adapter.release.MarkReleaseFailed("")
+			adapter.release.MarkRetried()
+			adapter.release.MarkManagedPipelineProcessing()
+
+			newReleasePlanAdmission := releasePlanAdmission.DeepCopy()
+			newReleasePlanAdmission.Spec.Retries = 2
 
-			pipelineRun := &tektonv1.PipelineRun{
+			failedPipelineRun := &tektonv1.PipelineRun{
 				ObjectMeta: metav1.ObjectMeta{
-					Name:      "pipeline-run",
+					Name:      "failed-pipeline-run",
 					Namespace: "default",
 				},
 			}
-			pipelineRun.Status.MarkSucceeded("", "")
+			Expect(k8sClient.Create(ctx, failedPipelineRun)).To(Succeed())
+			failedPipelineRun.Status.MarkFailed("", "")
+			adapter.release.Status.ManagedProcessing.PipelineRun = "default/failed-pipeline-run"
 			adapter.ctx = toolkit.GetMockedContext(ctx, []toolkit.MockData{
 				{
 					ContextKey: loader.ReleasePipelineRunContextKey,
-					Resource:   pipelineRun,
+					Resource:   failedPipelineRun,
+				},
+				{
+					ContextKey: loader.ProcessingResourcesContextKey,
+					Resource: &loader.ProcessingResources{
+						EnterpriseContractConfigMap: enterpriseContractConfigMap,
+						EnterpriseContractPolicy:    enterpriseContractPolicy,
+						ReleasePlan:                 releasePlan,
+						ReleasePlanAdmission:        newReleasePlanAdmission,
+						Snapshot:                    snapshot,
+					},
 				},
 			})
 
-			result, err := adapter.EnsureTenantPipelineProcessingIsTracked()
-			Expect(!result.RequeueRequest && !result.CancelRequest).To(BeTrue())
-			Expect(err).NotTo(HaveOccurred())
-			Expect(adapter.release.HasTenantPipelineProcessingFinished()).To(BeTrue())
-		})
-
-		It("should continue if the PipelineRun doesn't exist", func() {
-			adapter.release.MarkTenantPipelineProcessing()
-
-			result, err := adapter.EnsureTenantPipelineProcessingIsTracked()
-			Expect(!result.RequeueRequest && !result.CancelRequest).To(BeTrue())
-			Expect(err).NotTo(HaveOccurred())
-		})
-	})
-
-	When("EnsureManagedPipelineProcessingIsTracked is called", func() {
-		var adapter *adapter
-
-		AfterEach(func() {
-			_ = adapter.client.Delete(ctx, adapter.release)
-		})
-
-		BeforeEach(func() {
-			adapter = createReleaseAndAdapter()
-		})
-
-		It("should continue if the Release managed pipeline processing has not started", func() {
 			result, err := adapter.EnsureManagedPipelineProcessingIsTracked()
 			Expect(!result.RequeueRequest && !result.CancelRequest).To(BeTrue())
 			Expect(err).NotTo(HaveOccurred())
-		})
-
-		It("should continue if the Release managed pipeline processing has finished", func() {
-			adapter.release.MarkManagedPipelineProcessing()
-			adapter.release.MarkManagedPipelineProcessed()
+			Expect(adapter.release.IsFailed()).To(BeFalse())
+			Expect(adapter.release.Status.Attempts).To(Equal(1))
+			Expect(adapter.release.Status.ManagedProcessing.Retries).To(Equal(1))
+			Expect(adapter.release.Status.ManagedProcessing.PipelineRun).NotTo(Equal("default/failed-pipeline-run"))
 
-			result, err := adapter.EnsureManagedPipelineProcessingIsTracked()
-			Expect(!result.RequeueRequest && !result.CancelRequest).To(BeTrue())
-			Expect(err).NotTo(HaveOccurred())
+			Expect(k8sClient.Delete(ctx, failedPipelineRun)).To(Succeed())
 		})
 
-		It("should track the status if the PipelineRun exists", func() {
+		It("should requeue if the PipelineRun found is not the one registered after a retry", func() {
 			adapter.release.MarkManagedPipelineProcessing()
+			adapter.release.Status.ManagedProcessing.Retries = 1
+			adapter.release.Status.ManagedProcessing.PipelineRun = "default/new-pipeline-run"
 
 			pipelineRun := &tektonv1.PipelineRun{
 				ObjectMeta: metav1.ObjectMeta{
-					Name:      "pipeline-run",
+					Name:      "failed-pipeline-run",
 					Namespace: "default",
 				},
 			}
-			pipelineRun.Status.MarkSucceeded("", "")
+			pipelineRun.Status.MarkFailed("", "")
 			adapter.ctx = toolkit.GetMockedContext(ctx, []toolkit.MockData{
 				{
 					ContextKey: loader.ReleasePipelineRunContextKey,
 					Resource:   pipelineRun,
 				},
-				{
-					ContextKey: loader.RoleBindingContextKey,
-				},
 			})
 
 			result, err := adapter.EnsureManagedPipelineProcessingIsTracked()
-			Expect(!result.RequeueRequest && !result.CancelRequest).To(BeTrue())
+			Expect(result.RequeueRequest).To(BeTrue())
 			Expect(err).NotTo(HaveOccurred())
-			Expect(adapter.release.HasManagedPipelineProcessingFinished()).To(BeTrue())
+			Expect(adapter.release.HasManagedPipelineProcessingFinished()).To(BeFalse())
 		})
 
 		It("should continue if the PipelineRun doesn't exist", func() {
@@ -2052,6 +2848,25 @@ var _ = Describe("Release adapter", Ordered, func() {
 			Expect(err).NotTo(HaveOccurred())
 		})
 
+		It("should set the ExpirationTime with the value of ReleasePlan's ExpirationTime and then continue", func() {
+			newReleasePlan.Spec.ExpirationTime = &metav1.Duration{Duration: 2 * time.Hour}
+			creationTime := adapter.release.CreationTimestamp
+			expectedExpirationTime := &metav1.Time{Time: creationTime.Add(2 * time.Hour)}
+
+			result, err := adapter.EnsureReleaseExpirationTimeIsAdded()
+			Expect(adapter.release.Status.ExpirationTime).To(Equal(expectedExpirationTime))
+			Expect(adapter.release.Status.DeleteOnExpiration).To(BeTrue())
+			Expect(!result.RequeueRequest && !result.CancelRequest).To(BeTrue())
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("should not set the Release to be deleted on expiration if the ReleasePlan has no ExpirationTime", func() {
+			result, err := adapter.EnsureReleaseExpirationTimeIsAdded()
+			Expect(adapter.release.Status.DeleteOnExpiration).To(BeFalse())
+			Expect(!result.RequeueRequest && !result.CancelRequest).To(BeTrue())
+			Expect(err).NotTo(HaveOccurred())
+		})
+
 		It("should not change the ExpirationTime if it is already set", func() {
 			expireDays := time.Duration(5)
 			creationTime := adapter.release.CreationTimestamp
@@ -2228,127 +3043,416 @@ var _ = Describe("Release adapter", Ordered, func() {
 			Expect(checkSecretRole).To(Equal(&rbac.Role{}))
 			Expect(errors.IsNotFound(err)).To(BeTrue())
 
-			checkSecretRoleBinding := &rbac.RoleBinding{}
-			err = toolkit.GetObject(secretRoleBinding.Name, secretRoleBinding.Namespace, adapter.client, adapter.ctx, checkSecretRoleBinding)
-			Expect(checkSecretRoleBinding).To(Equal(&rbac.RoleBinding{}))
+			checkSecretRoleBinding := &rbac.RoleBinding{}
+			err = toolkit.GetObject(secretRoleBinding.Name, secretRoleBinding.Namespace, adapter.client, adapter.ctx, checkSecretRoleBinding)
+			Expect(checkSecretRoleBinding).To(Equal(&rbac.RoleBinding{}))
+			Expect(errors.IsNotFound(err)).To(BeTrue())
+		})
+	})
+
+	When("EnsureReleaseProcessingResourcesAreCleanedUp is called", func() {
+		var adapter *adapter
+
+		AfterEach(func() {
+			_ = adapter.client.Delete(ctx, adapter.release)
+		})
+
+		BeforeEach(func() {
+			adapter = createReleaseAndAdapter()
+		})
+
+		It("should continue if the Release tenant processing has not finished", func() {
+			adapter.release.MarkManagedPipelineProcessingSkipped()
+			result, err := adapter.EnsureReleaseProcessingResourcesAreCleanedUp()
+			Expect(!result.RequeueRequest && !result.CancelRequest).To(BeTrue())
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("should continue if the Release managed processing has not finished", func() {
+			adapter.release.MarkTenantPipelineProcessingSkipped()
+			result, err := adapter.EnsureReleaseProcessingResourcesAreCleanedUp()
+			Expect(!result.RequeueRequest && !result.CancelRequest).To(BeTrue())
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("Should continue if the Release final processing has not finished", func() {
+			adapter.release.MarkFinalPipelineProcessingSkipped()
+			result, err := adapter.EnsureReleaseProcessingResourcesAreCleanedUp()
+			Expect(!result.RequeueRequest && !result.CancelRequest).To(BeTrue())
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("should call finalizeRelease with false if all release processing is complete", func() {
+			adapter.releaseServiceConfig = releaseServiceConfig
+			resources := &loader.ProcessingResources{
+				ReleasePlan:                 releasePlan,
+				ReleasePlanAdmission:        releasePlanAdmission,
+				EnterpriseContractConfigMap: enterpriseContractConfigMap,
+				EnterpriseContractPolicy:    enterpriseContractPolicy,
+				Snapshot:                    snapshot,
+			}
+			parameterizedPipeline := tektonutils.ParameterizedPipeline{}
+			parameterizedPipeline.PipelineRef = tektonutils.PipelineRef{
+				Resolver: "git",
+				Params: []tektonutils.Param{
+					{Name: "url", Value: "my-url"},
+					{Name: "revision", Value: "my-revision"},
+					{Name: "pathInRepo", Value: "my-path"},
+				},
+			}
+			parameterizedPipeline.Params = []tektonutils.Param{
+				{Name: "parameter1", Value: "value1"},
+				{Name: "parameter2", Value: "value2"},
+			}
+			parameterizedPipeline.Timeouts = tektonv1.TimeoutFields{
+				Pipeline: &metav1.Duration{Duration: 1 * time.Hour},
+			}
+
+			newReleasePlan := &v1alpha1.ReleasePlan{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "release-plan",
+					Namespace: "default",
+				},
+				Spec: v1alpha1.ReleasePlanSpec{
+					Application:            application.Name,
+					TenantPipeline:         &parameterizedPipeline,
+					FinalPipeline:          &parameterizedPipeline,
+					ReleaseGracePeriodDays: 6,
+				},
+			}
+			newReleasePlan.Kind = "ReleasePlan"
+
+			// Create tenant, managed, final and pipelineRuns
+			pipelineRun, err := adapter.createTenantPipelineRun(newReleasePlan, snapshot)
+			Expect(pipelineRun).NotTo(BeNil())
+			Expect(err).NotTo(HaveOccurred())
+
+			pipelineRun, err = adapter.createManagedPipelineRun(resources)
+			Expect(pipelineRun).NotTo(BeNil())
+			Expect(err).NotTo(HaveOccurred())
+
+			pipelineRun, err = adapter.createFinalPipelineRun(newReleasePlan, snapshot)
+			Expect(pipelineRun).NotTo(BeNil())
+			Expect(err).NotTo(HaveOccurred())
+
+			adapter.release.MarkTenantPipelineProcessing()
+			adapter.release.MarkTenantPipelineProcessed()
+			adapter.release.MarkManagedPipelineProcessing()
+			adapter.release.MarkManagedPipelineProcessed()
+			adapter.release.MarkFinalPipelineProcessing()
+			adapter.release.MarkFinalPipelineProcessed()
+
+			// Ensure all pipelineRuns have finalizers removed
+			result, err := adapter.EnsureReleaseProcessingResourcesAreCleanedUp()
+			Expect(!result.RequeueRequest && !result.CancelRequest).To(BeTrue())
+			Expect(err).NotTo(HaveOccurred())
+
+			pipelineRun, err = adapter.loader.GetReleasePipelineRun(adapter.ctx, adapter.client, adapter.release, metadata.TenantPipelineType)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(pipelineRun).NotTo(BeNil())
+			Expect(pipelineRun.Finalizers).To(HaveLen(0))
+			Expect(k8sClient.Delete(ctx, pipelineRun)).To(Succeed())
+
+			pipelineRun, err = adapter.loader.GetReleasePipelineRun(adapter.ctx, adapter.client, adapter.release, metadata.ManagedPipelineType)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(pipelineRun).NotTo(BeNil())
+			Expect(pipelineRun.Finalizers).To(HaveLen(0))
+			Expect(k8sClient.Delete(ctx, pipelineRun)).To(Succeed())
+
+			pipelineRun, err = adapter.loader.GetReleasePipelineRun(adapter.ctx, adapter.client, adapter.release, metadata.FinalPipelineType)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(pipelineRun).NotTo(BeNil())
+			Expect(pipelineRun.Finalizers).To(HaveLen(0))
+			Expect(k8sClient.Delete(ctx, pipelineRun)).To(Succeed())
+		})
+	})
+
+	When("EnsureExpiredReleaseIsDeleted is called", func() {
+		var adapter *adapter
+		var newReleasePlan *v1alpha1.ReleasePlan
+		var releaseKey types.NamespacedName
+
+		AfterEach(func() {
+			_ = adapter.client.Delete(ctx, adapter.release)
+		})
+
+		BeforeEach(func() {
+			adapter = createReleaseAndAdapter()
+			adapter.release.MarkReleasing("")
+			adapter.release.MarkReleased()
+			releaseKey = types.NamespacedName{Name: adapter.release.Name, Namespace: adapter.release.Namespace}
+
+			newReleasePlan = &v1alpha1.ReleasePlan{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "release-plan",
+					Namespace: "default",
+				},
+				Spec: v1alpha1.ReleasePlanSpec{
+					Application: application.Name,
+					Target:      "default",
+				},
+			}
+			adapter.ctx = toolkit.GetMockedContext(ctx, []toolkit.MockData{
+				{
+					ContextKey: loader.ReleasePlanContextKey,
+					Resource:   newReleasePlan,
+				},
+			})
+		})
+
+		It("should continue if the Release has not finished", func() {
+			adapter.release.Status.Conditions = nil
+			adapter.release.Status.DeleteOnExpiration = true
+			adapter.release.Status.ExpirationTime = &metav1.Time{Time: time.Now().Add(-time.Hour)}
+
+			result, err := adapter.EnsureExpiredReleaseIsDeleted()
+			Expect(!result.RequeueRequest && !result.CancelRequest).To(BeTrue())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(adapter.client.Get(ctx, releaseKey, adapter.release)).To(Succeed())
+		})
+
+		It("should continue if the Release is not set to be deleted on expiration", func() {
+			adapter.release.Status.ExpirationTime = &metav1.Time{Time: time.Now().Add(-time.Hour)}
+
+			result, err := adapter.EnsureExpiredReleaseIsDeleted()
+			Expect(!result.RequeueRequest && !result.CancelRequest).To(BeTrue())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(adapter.client.Get(ctx, releaseKey, adapter.release)).To(Succeed())
+		})
+
+		It("should requeue the Release until its ExpirationTime is reached", func() {
+			adapter.release.Status.DeleteOnExpiration = true
+			adapter.release.Status.ExpirationTime = &metav1.Time{Time: time.Now().Add(time.Hour)}
+
+			result, err := adapter.EnsureExpiredReleaseIsDeleted()
+			Expect(result.RequeueRequest && !result.CancelRequest).To(BeTrue())
+			Expect(result.RequeueDelay).To(BeNumerically("~", time.Hour, time.Minute))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(adapter.client.Get(ctx, releaseKey, adapter.release)).To(Succeed())
+		})
+
+		It("should not delete the Release if its creation time is ahead of the local clock", func() {
+			newReleasePlan.Spec.ExpirationTime = &metav1.Duration{Duration: time.Minute}
+			newReleasePlan.Spec.ExpireExistingReleases = true
+			adapter.release.CreationTimestamp = metav1.Time{Time: time.Now().Add(time.Hour)}
+
+			result, err := adapter.EnsureExpiredReleaseIsDeleted()
+			Expect(result.RequeueRequest && !result.CancelRequest).To(BeTrue())
+			Expect(result.RequeueDelay).To(BeNumerically("~", time.Hour+time.Minute, time.Minute))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(adapter.client.Get(ctx, releaseKey, adapter.release)).To(Succeed())
+		})
+
+		It("should delete the Release once its ExpirationTime is reached", func() {
+			adapter.release.Status.DeleteOnExpiration = true
+			adapter.release.Status.ExpirationTime = &metav1.Time{Time: time.Now()}
+
+			result, err := adapter.EnsureExpiredReleaseIsDeleted()
+			Expect(!result.RequeueRequest && result.CancelRequest).To(BeTrue())
+			Expect(err).NotTo(HaveOccurred())
+
+			err = adapter.client.Get(ctx, releaseKey, adapter.release)
 			Expect(errors.IsNotFound(err)).To(BeTrue())
 		})
+
+		It("should not apply the ReleasePlan ExpirationTime to existing Releases by default", func() {
+			newReleasePlan.Spec.ExpirationTime = &metav1.Duration{Duration: time.Minute}
+			adapter.release.CreationTimestamp = metav1.Time{Time: time.Now().Add(-time.Hour)}
+
+			result, err := adapter.EnsureExpiredReleaseIsDeleted()
+			Expect(!result.RequeueRequest && !result.CancelRequest).To(BeTrue())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(adapter.release.Status.DeleteOnExpiration).To(BeFalse())
+		})
+
+		It("should apply the ReleasePlan ExpirationTime to existing Releases if the ReleasePlan expires them", func() {
+			newReleasePlan.Spec.ExpirationTime = &metav1.Duration{Duration: 2 * time.Hour}
+			newReleasePlan.Spec.ExpireExistingReleases = true
+			adapter.release.Status.DeleteOnExpiration = true
+			adapter.release.Status.ExpirationTime = &metav1.Time{Time: time.Now().Add(-time.Hour)}
+
+			result, err := adapter.EnsureExpiredReleaseIsDeleted()
+			Expect(result.RequeueRequest && !result.CancelRequest).To(BeTrue())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(adapter.release.Status.ExpirationTime.Unix()).To(Equal(
+				adapter.release.CreationTimestamp.Add(2 * time.Hour).Unix()))
+		})
 	})
 
-	When("EnsureReleaseProcessingResourcesAreCleanedUp is called", func() {
+	When("EnsureReleasePipelineRunsAreGarbageCollected is called", func() {
 		var adapter *adapter
+		var pipelineRun *tektonv1.PipelineRun
+		var pipelineRunKey types.NamespacedName
 
 		AfterEach(func() {
 			_ = adapter.client.Delete(ctx, adapter.release)
+			if k8sClient.Get(ctx, pipelineRunKey, pipelineRun) == nil {
+				pipelineRun.Finalizers = nil
+				_ = k8sClient.Update(ctx, pipelineRun)
+				_ = k8sClient.Delete(ctx, pipelineRun)
+			}
 		})
 
 		BeforeEach(func() {
 			adapter = createReleaseAndAdapter()
-		})
+			adapter.release.MarkReleasing("")
+			adapter.release.MarkReleased()
 
-		It("should continue if the Release tenant processing has not finished", func() {
-			adapter.release.MarkManagedPipelineProcessingSkipped()
-			result, err := adapter.EnsureReleaseProcessingResourcesAreCleanedUp()
-			Expect(!result.RequeueRequest && !result.CancelRequest).To(BeTrue())
-			Expect(err).NotTo(HaveOccurred())
+			pipelineRun = &tektonv1.PipelineRun{
+				ObjectMeta: metav1.ObjectMeta{
+					GenerateName: "pipeline-run-",
+					Namespace:    "default",
+					Finalizers:   []string{metadata.ReleaseFinalizer},
+				},
+			}
+			Expect(k8sClient.Create(ctx, pipelineRun)).To(Succeed())
+			pipelineRun.Status.MarkSucceeded("", "")
+			pipelineRunKey = types.NamespacedName{Name: pipelineRun.Name, Namespace: pipelineRun.Namespace}
 		})
 
-		It("should continue if the Release managed processing has not finished", func() {
-			adapter.release.MarkTenantPipelineProcessingSkipped()
-			result, err := adapter.EnsureReleaseProcessingResourcesAreCleanedUp()
+		mockPipelineRunAndGracePeriod := func(gracePeriodDays int, namespace string) {
+			newReleasePlanAdmission := releasePlanAdmission.DeepCopy()
+			newReleasePlanAdmission.Namespace = namespace
+			newReleasePlanAdmission.Spec.ReleaseGracePeriodDays = gracePeriodDays
+
+			adapter.ctx = toolkit.GetMockedContext(ctx, []toolkit.MockData{
+				{
+					ContextKey: loader.ReleasePipelineRunsContextKey,
+					Resource: &tektonv1.PipelineRunList{
+						Items: []tektonv1.PipelineRun{*pipelineRun},
+					},
+				},
+				{
+					ContextKey: loader.ReleasePlanAdmissionContextKey,
+					Resource:   newReleasePlanAdmission,
+				},
+			})
+		}
+
+		It("should continue if the Release has not finished", func() {
+			adapter.release.Status.Conditions = nil
+			mockPipelineRunAndGracePeriod(0, "default")
+
+			result, err := adapter.EnsureReleasePipelineRunsAreGarbageCollected()
 			Expect(!result.RequeueRequest && !result.CancelRequest).To(BeTrue())
 			Expect(err).NotTo(HaveOccurred())
+
+			Expect(k8sClient.Get(ctx, pipelineRunKey, pipelineRun)).To(Succeed())
+			Expect(pipelineRun.Finalizers).To(ContainElement(metadata.ReleaseFinalizer))
 		})
 
-		It("Should continue if the Release final processing has not finished", func() {
-			adapter.release.MarkFinalPipelineProcessingSkipped()
-			result, err := adapter.EnsureReleaseProcessingResourcesAreCleanedUp()
+		It("should remove the finalizer from the finished PipelineRuns", func() {
+			mockPipelineRunAndGracePeriod(0, "default")
+
+			result, err := adapter.EnsureReleasePipelineRunsAreGarbageCollected()
 			Expect(!result.RequeueRequest && !result.CancelRequest).To(BeTrue())
 			Expect(err).NotTo(HaveOccurred())
+
+			Expect(k8sClient.Get(ctx, pipelineRunKey, pipelineRun)).To(Succeed())
+			Expect(pipelineRun.Finalizers).To(BeEmpty())
 		})
 
-		It("should call finalizeRelease with false if all release processing is complete", func() {
-			adapter.releaseServiceConfig = releaseServiceConfig
-			resources := &loader.ProcessingResources{
-				ReleasePlan:                 releasePlan,
-				ReleasePlanAdmission:        releasePlanAdmission,
-				EnterpriseContractConfigMap: enterpriseContractConfigMap,
-				EnterpriseContractPolicy:    enterpriseContractPolicy,
-				Snapshot:                    snapshot,
-			}
-			parameterizedPipeline := tektonutils.ParameterizedPipeline{}
-			parameterizedPipeline.PipelineRef = tektonutils.PipelineRef{
-				Resolver: "git",
-				Params: []tektonutils.Param{
-					{Name: "url", Value: "my-url"},
-					{Name: "revision", Value: "my-revision"},
-					{Name: "pathInRepo", Value: "my-path"},
+		It("should requeue with error if the ReleasePlanAdmission can't be fetched", func() {
+			adapter.ctx = toolkit.GetMockedContext(ctx, []toolkit.MockData{
+				{
+					ContextKey: loader.ReleasePipelineRunsContextKey,
+					Resource: &tektonv1.PipelineRunList{
+						Items: []tektonv1.PipelineRun{*pipelineRun},
+					},
 				},
-			}
-			parameterizedPipeline.Params = []tektonutils.Param{
-				{Name: "parameter1", Value: "value1"},
-				{Name: "parameter2", Value: "value2"},
-			}
-			parameterizedPipeline.Timeouts = tektonv1.TimeoutFields{
-				Pipeline: &metav1.Duration{Duration: 1 * time.Hour},
-			}
+				{
+					ContextKey: loader.ReleasePlanAdmissionContextKey,
+					Err:        fmt.Errorf("some error"),
+				},
+			})
 
-			newReleasePlan := &v1alpha1.ReleasePlan{
-				ObjectMeta: metav1.ObjectMeta{
-					Name:      "release-plan",
-					Namespace: "default",
+			result, err := adapter.EnsureReleasePipelineRunsAreGarbageCollected()
+			Expect(result.RequeueRequest && !result.CancelRequest).To(BeTrue())
+			Expect(err).To(HaveOccurred())
+
+			Expect(k8sClient.Get(ctx, pipelineRunKey, pipelineRun)).To(Succeed())
+			Expect(pipelineRun.Finalizers).To(ContainElement(metadata.ReleaseFinalizer))
+		})
+
+		It("should remove the finalizer from the finished PipelineRuns if there is no active ReleasePlanAdmission", func() {
+			adapter.ctx = toolkit.GetMockedContext(ctx, []toolkit.MockData{
+				{
+					ContextKey: loader.ReleasePipelineRunsContextKey,
+					Resource: &tektonv1.PipelineRunList{
+						Items: []tektonv1.PipelineRun{*pipelineRun},
+					},
 				},
-				Spec: v1alpha1.ReleasePlanSpec{
-					Application:            application.Name,
-					TenantPipeline:         &parameterizedPipeline,
-					FinalPipeline:          &parameterizedPipeline,
-					ReleaseGracePeriodDays: 6,
+				{
+					ContextKey: loader.ReleasePlanAdmissionContextKey,
+					Err:        loader.ErrReleasePlanAdmissionNotFound,
 				},
-			}
-			newReleasePlan.Kind = "ReleasePlan"
+			})
 
-			// Create tenant, managed, final and pipelineRuns
-			pipelineRun, err := adapter.createTenantPipelineRun(newReleasePlan, snapshot)
-			Expect(pipelineRun).NotTo(BeNil())
+			result, err := adapter.EnsureReleasePipelineRunsAreGarbageCollected()
+			Expect(!result.RequeueRequest && !result.CancelRequest).To(BeTrue())
 			Expect(err).NotTo(HaveOccurred())
 
-			pipelineRun, err = adapter.createManagedPipelineRun(resources)
-			Expect(pipelineRun).NotTo(BeNil())
+			Expect(k8sClient.Get(ctx, pipelineRunKey, pipelineRun)).To(Succeed())
+			Expect(pipelineRun.Finalizers).To(BeEmpty())
+		})
+
+		It("should not remove the finalizer from the PipelineRuns still running", func() {
+			pipelineRun.Status = tektonv1.PipelineRunStatus{}
+			mockPipelineRunAndGracePeriod(0, "default")
+
+			result, err := adapter.EnsureReleasePipelineRunsAreGarbageCollected()
+			Expect(!result.RequeueRequest && !result.CancelRequest).To(BeTrue())
 			Expect(err).NotTo(HaveOccurred())
 
-			pipelineRun, err = adapter.createFinalPipelineRun(newReleasePlan, snapshot)
-			Expect(pipelineRun).NotTo(BeNil())
+			Expect(k8sClient.Get(ctx, pipelineRunKey, pipelineRun)).To(Succeed())
+			Expect(pipelineRun.Finalizers).To(ContainElement(metadata.ReleaseFinalizer))
+		})
+
+		It("should requeue until the grace period of the PipelineRuns expires", func() {
+			pipelineRun.Status.CompletionTime = &metav1.Time{Time: time.Now()}
+			mockPipelineRunAndGracePeriod(1, "default")
+
+			result, err := adapter.EnsureReleasePipelineRunsAreGarbageCollected()
+			Expect(result.RequeueRequest && !result.CancelRequest).To(BeTrue())
+			Expect(result.RequeueDelay).To(BeNumerically("~", 24*time.Hour, time.Minute))
 			Expect(err).NotTo(HaveOccurred())
 
-			adapter.release.MarkTenantPipelineProcessing()
-			adapter.release.MarkTenantPipelineProcessed()
-			adapter.release.MarkManagedPipelineProcessing()
-			adapter.release.MarkManagedPipelineProcessed()
-			adapter.release.MarkFinalPipelineProcessing()
-			adapter.release.MarkFinalPipelineProcessed()
+			Expect(k8sClient.Get(ctx, pipelineRunKey, pipelineRun)).To(Succeed())
+		})
 
-			// Ensure all pipelineRuns have finalizers removed
-			result, err := adapter.EnsureReleaseProcessingResourcesAreCleanedUp()
+		It("should delete the PipelineRuns once the grace period expires", func() {
+			pipelineRun.Status.CompletionTime = &metav1.Time{Time: time.Now().Add(-48 * time.Hour)}
+			mockPipelineRunAndGracePeriod(1, "default")
+
+			result, err := adapter.EnsureReleasePipelineRunsAreGarbageCollected()
 			Expect(!result.RequeueRequest && !result.CancelRequest).To(BeTrue())
 			Expect(err).NotTo(HaveOccurred())
 
-			pipelineRun, err = adapter.loader.GetReleasePipelineRun(adapter.ctx, adapter.client, adapter.release, metadata.TenantPipelineType)
-			Expect(err).NotTo(HaveOccurred())
-			Expect(pipelineRun).NotTo(BeNil())
-			Expect(pipelineRun.Finalizers).To(HaveLen(0))
-			Expect(k8sClient.Delete(ctx, pipelineRun)).To(Succeed())
+			err = k8sClient.Get(ctx, pipelineRunKey, pipelineRun)
+			Expect(errors.IsNotFound(err)).To(BeTrue())
+		})
 
-			pipelineRun, err = adapter.loader.GetReleasePipelineRun(adapter.ctx, adapter.client, adapter.release, metadata.ManagedPipelineType)
+		It("should not delete the PipelineRuns with the keep annotation", func() {
+			pipelineRun.Annotations = map[string]string{metadata.KeepAnnotation: "true"}
+			pipelineRun.Status.CompletionTime = &metav1.Time{Time: time.Now().Add(-48 * time.Hour)}
+			mockPipelineRunAndGracePeriod(1, "default")
+
+			result, err := adapter.EnsureReleasePipelineRunsAreGarbageCollected()
+			Expect(!result.RequeueRequest && !result.CancelRequest).To(BeTrue())
 			Expect(err).NotTo(HaveOccurred())
-			Expect(pipelineRun).NotTo(BeNil())
-			Expect(pipelineRun.Finalizers).To(HaveLen(0))
-			Expect(k8sClient.Delete(ctx, pipelineRun)).To(Succeed())
 
-			pipelineRun, err = adapter.loader.GetReleasePipelineRun(adapter.ctx, adapter.client, adapter.release, metadata.FinalPipelineType)
+			Expect(k8sClient.Get(ctx, pipelineRunKey, pipelineRun)).To(Succeed())
+		})
+
+		It("should not delete the PipelineRuns outside the ReleasePlanAdmission namespace", func() {
+			pipelineRun.Status.CompletionTime = &metav1.Time{Time: time.Now().Add(-48 * time.Hour)}
+			mockPipelineRunAndGracePeriod(1, "other")
+
+			result, err := adapter.EnsureReleasePipelineRunsAreGarbageCollected()
+			Expect(!result.RequeueRequest && !result.CancelRequest).To(BeTrue())
 			Expect(err).NotTo(HaveOccurred())
-			Expect(pipelineRun).NotTo(BeNil())
-			Expect(pipelineRun.Finalizers).To(HaveLen(0))
-			Expect(k8sClient.Delete(ctx, pipelineRun)).To(Succeed())
+
+			Expect(k8sClient.Get(ctx, pipelineRunKey, pipelineRun)).To(Succeed())
 		})
 	})
 
@@ -2447,6 +3551,61 @@ var _ = Describe("Release adapter", Ordered, func() {
 			Expect(adapter.client.Delete(adapter.ctx, checkPipelineRun)).To(Succeed())
 		})
 
+		It("removes the EnterpriseContractPolicy ConfigMaps not owned by other PipelineRuns", func() {
+			pipelineRun := &tektonv1.PipelineRun{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "managed-pipeline-run",
+					Namespace: "default",
+					Labels: map[string]string{
+						metadata.PipelinesTypeLabel: metadata.ManagedPipelineType.String(),
+					},
+				},
+			}
+			Expect(adapter.client.Create(adapter.ctx, pipelineRun)).To(Succeed())
+			otherPipelineRun := &tektonv1.PipelineRun{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "other-managed-pipeline-run",
+					Namespace: "default",
+				},
+			}
+			Expect(adapter.client.Create(adapter.ctx, otherPipelineRun)).To(Succeed())
+
+			labels := map[string]string{
+				metadata.ServiceNameLabel:      metadata.ServiceName,
+				metadata.ReleaseNameLabel:      metadata.SanitizeLabelValue(adapter.release.Name),
+				metadata.ReleaseNamespaceLabel: metadata.SanitizeLabelValue(adapter.release.Namespace),
+			}
+			orphanedConfigMap := &corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "orphaned-ec-policy",
+					Namespace: "default",
+					Labels:    labels,
+				},
+			}
+			Expect(adapter.client.Create(adapter.ctx, orphanedConfigMap)).To(Succeed())
+			otherConfigMap := &corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "other-ec-policy",
+					Namespace: "default",
+					Labels:    labels,
+				},
+			}
+			Expect(ctrl.SetControllerReference(otherPipelineRun, otherConfigMap, adapter.client.Scheme())).To(Succeed())
+			Expect(adapter.client.Create(adapter.ctx, otherConfigMap)).To(Succeed())
+
+			err := adapter.cleanupProcessingResources(pipelineRun)
+			Expect(err).NotTo(HaveOccurred())
+
+			err = toolkit.GetObject(orphanedConfigMap.Name, orphanedConfigMap.Namespace, adapter.client, adapter.ctx, &corev1.ConfigMap{})
+			Expect(errors.IsNotFound(err)).To(BeTrue())
+			err = toolkit.GetObject(otherConfigMap.Name, otherConfigMap.Namespace, adapter.client, adapter.ctx, &corev1.ConfigMap{})
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(adapter.client.Delete(adapter.ctx, otherConfigMap)).To(Succeed())
+			Expect(adapter.client.Delete(adapter.ctx, otherPipelineRun)).To(Succeed())
+			Expect(adapter.client.Delete(adapter.ctx, pipelineRun)).To(Succeed())
+		})
+
 		It("should not error if either resource is nil", func() {
 			err := adapter.cleanupProcessingResources(nil, nil)
 			Expect(err).NotTo(HaveOccurred())
@@ -2735,11 +3894,50 @@ var _ = Describe("Release adapter", Ordered, func() {
 				fmt.Sprintf("%s%c%s", snapshot.Namespace, types.Separator, snapshot.Name))))
 		})
 
+		It("has the snapshot spec", func() {
+			Expect(pipelineRun.Spec.Params).Should(ContainElement(HaveField("Name", "snapshot_spec")))
+		})
+
+		It("has no duplicate params", func() {
+			names := map[string]bool{}
+			for _, param := range pipelineRun.Spec.Params {
+				Expect(names).NotTo(HaveKey(param.Name))
+				names[param.Name] = true
+			}
+		})
+
+		It("overrides the ReleasePlan params with the Release ones", func() {
+			adapter.release.Spec.Params = []tektonv1.Param{
+				{Name: "parameter1", Value: *tektonv1.NewStructuredValues("release-value")},
+				{Name: "parameter3", Value: *tektonv1.NewStructuredValues("foo", "bar")},
+			}
+
+			newPipelineRun, err := adapter.createTenantPipelineRun(newReleasePlan, snapshot)
+			Expect(err).NotTo(HaveOccurred())
+			defer func() {
+				Expect(k8sClient.Delete(ctx, newPipelineRun)).To(Succeed())
+			}()
+
+			Expect(newPipelineRun.Spec.Params).To(ContainElement(tektonv1.Param{
+				Name: "parameter1", Value: *tektonv1.NewStructuredValues("release-value"),
+			}))
+			Expect(newPipelineRun.Spec.Params).To(ContainElement(tektonv1.Param{
+				Name: "parameter2", Value: *tektonv1.NewStructuredValues("value2"),
+			}))
+			Expect(newPipelineRun.Spec.Params).To(ContainElement(tektonv1.Param{
+				Name: "parameter3", Value: *tektonv1.NewStructuredValues("foo", "bar"),
+			}))
+		})
+
 		It("has owner annotations", func() {
 			Expect(pipelineRun.GetAnnotations()[handler.NamespacedNameAnnotation]).To(ContainSubstring(adapter.release.Name))
 			Expect(pipelineRun.GetAnnotations()[handler.TypeAnnotation]).To(ContainSubstring("Release"))
 		})
 
+		It("is owned by the Release as they share the namespace", func() {
+			Expect(pipelineRun.OwnerReferences).To(ContainElement(HaveField("UID", adapter.release.UID)))
+		})
+
 		It("has release labels", func() {
 			Expect(pipelineRun.GetLabels()[metadata.PipelinesTypeLabel]).To(Equal(metadata.TenantPipelineType.String()))
 			Expect(pipelineRun.GetLabels()[metadata.ReleaseNameLabel]).To(Equal(adapter.release.Name))
@@ -2830,57 +4028,107 @@ var _ = Describe("Release adapter", Ordered, func() {
 				fmt.Sprintf("%s%c%s", adapter.release.Namespace, types.Separator, adapter.release.Name))))
 		})
 
-		It("has the releasePlan reference", func() {
+		It("has the ReleasePlanAdmission label", func() {
+			var err error
+			pipelineRun, err = adapter.createManagedPipelineRun(resources)
+			Expect(pipelineRun).NotTo(BeNil())
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(pipelineRun.Labels).To(HaveKeyWithValue(metadata.ReleasePlanAdmissionLabel, releasePlanAdmission.Name))
+		})
+
+		It("has the releasePlan reference", func() {
+			var err error
+			pipelineRun, err = adapter.createManagedPipelineRun(resources)
+			Expect(pipelineRun).NotTo(BeNil())
+			Expect(err).NotTo(HaveOccurred())
+
+			name := []rune(releasePlan.Kind)
+			name[0] = unicode.ToLower(name[0])
+
+			Expect(pipelineRun.Spec.Params).Should(ContainElement(HaveField("Name", string(name))))
+			Expect(pipelineRun.Spec.Params).Should(ContainElement(HaveField("Value.StringVal",
+				fmt.Sprintf("%s%c%s", releasePlan.Namespace, types.Separator, releasePlan.Name))))
+		})
+
+		It("has the releasePlanAdmission reference", func() {
+			var err error
+			pipelineRun, err = adapter.createManagedPipelineRun(resources)
+			Expect(pipelineRun).NotTo(BeNil())
+			Expect(err).NotTo(HaveOccurred())
+
+			name := []rune(releasePlanAdmission.Kind)
+			name[0] = unicode.ToLower(name[0])
+
+			Expect(pipelineRun.Spec.Params).Should(ContainElement(HaveField("Name", string(name))))
+			Expect(pipelineRun.Spec.Params).Should(ContainElement(HaveField("Value.StringVal",
+				fmt.Sprintf("%s%c%s", releasePlanAdmission.Namespace, types.Separator, releasePlanAdmission.Name))))
+		})
+
+		It("has the releaseServiceConfig reference", func() {
+			var err error
+			pipelineRun, err = adapter.createManagedPipelineRun(resources)
+			Expect(pipelineRun).NotTo(BeNil())
+			Expect(err).NotTo(HaveOccurred())
+
+			name := []rune(releaseServiceConfig.Kind)
+			name[0] = unicode.ToLower(name[0])
+
+			Expect(pipelineRun.Spec.Params).Should(ContainElement(HaveField("Name", string(name))))
+			Expect(pipelineRun.Spec.Params).Should(ContainElement(HaveField("Value.StringVal",
+				fmt.Sprintf("%s%c%s", releaseServiceConfig.Namespace, types.Separator, releaseServiceConfig.Name))))
+		})
+
+		It("has the snapshot reference", func() {
+			var err error
+			pipelineRun, err = adapter.createManagedPipelineRun(resources)
+			Expect(pipelineRun).NotTo(BeNil())
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(pipelineRun.Spec.Params).Should(ContainElement(HaveField("Name", strings.ToLower(snapshot.Kind))))
+			Expect(pipelineRun.Spec.Params).Should(ContainElement(HaveField("Value.StringVal",
+				fmt.Sprintf("%s%c%s", snapshot.Namespace, types.Separator, snapshot.Name))))
+		})
+
+		It("has the snapshot spec", func() {
 			var err error
 			pipelineRun, err = adapter.createManagedPipelineRun(resources)
 			Expect(pipelineRun).NotTo(BeNil())
 			Expect(err).NotTo(HaveOccurred())
 
-			name := []rune(releasePlan.Kind)
-			name[0] = unicode.ToLower(name[0])
-
-			Expect(pipelineRun.Spec.Params).Should(ContainElement(HaveField("Name", string(name))))
-			Expect(pipelineRun.Spec.Params).Should(ContainElement(HaveField("Value.StringVal",
-				fmt.Sprintf("%s%c%s", releasePlan.Namespace, types.Separator, releasePlan.Name))))
+			Expect(pipelineRun.Spec.Params).Should(ContainElement(HaveField("Name", "snapshot_spec")))
 		})
 
-		It("has the releasePlanAdmission reference", func() {
+		It("has the author of the Release as a label", func() {
+			adapter.release.Status.Attribution.Author = "user"
+
 			var err error
 			pipelineRun, err = adapter.createManagedPipelineRun(resources)
 			Expect(pipelineRun).NotTo(BeNil())
 			Expect(err).NotTo(HaveOccurred())
 
-			name := []rune(releasePlanAdmission.Kind)
-			name[0] = unicode.ToLower(name[0])
-
-			Expect(pipelineRun.Spec.Params).Should(ContainElement(HaveField("Name", string(name))))
-			Expect(pipelineRun.Spec.Params).Should(ContainElement(HaveField("Value.StringVal",
-				fmt.Sprintf("%s%c%s", releasePlanAdmission.Namespace, types.Separator, releasePlanAdmission.Name))))
+			Expect(pipelineRun.GetLabels()).To(HaveKeyWithValue(metadata.AuthorLabel, "user"))
 		})
 
-		It("has the releaseServiceConfig reference", func() {
+		It("has the release finalizer", func() {
 			var err error
 			pipelineRun, err = adapter.createManagedPipelineRun(resources)
 			Expect(pipelineRun).NotTo(BeNil())
 			Expect(err).NotTo(HaveOccurred())
 
-			name := []rune(releaseServiceConfig.Kind)
-			name[0] = unicode.ToLower(name[0])
-
-			Expect(pipelineRun.Spec.Params).Should(ContainElement(HaveField("Name", string(name))))
-			Expect(pipelineRun.Spec.Params).Should(ContainElement(HaveField("Value.StringVal",
-				fmt.Sprintf("%s%c%s", releaseServiceConfig.Namespace, types.Separator, releaseServiceConfig.Name))))
+			Expect(pipelineRun.Finalizers).To(ContainElement(metadata.ReleaseFinalizer))
 		})
 
-		It("has the snapshot reference", func() {
+		It("doesn't have the release finalizer if the PipelineRunConfig skips it", func() {
+			adapter.pipelineRunConfig.SkipPipelineRunFinalizer = true
+
 			var err error
 			pipelineRun, err = adapter.createManagedPipelineRun(resources)
 			Expect(pipelineRun).NotTo(BeNil())
 			Expect(err).NotTo(HaveOccurred())
 
-			Expect(pipelineRun.Spec.Params).Should(ContainElement(HaveField("Name", strings.ToLower(snapshot.Kind))))
-			Expect(pipelineRun.Spec.Params).Should(ContainElement(HaveField("Value.StringVal",
-				fmt.Sprintf("%s%c%s", snapshot.Namespace, types.Separator, snapshot.Name))))
+			Expect(pipelineRun.Finalizers).NotTo(ContainElement(metadata.ReleaseFinalizer))
+			Expect(pipelineRun.GetAnnotations()[handler.NamespacedNameAnnotation]).To(ContainSubstring(adapter.release.Name))
 		})
 
 		It("has owner annotations", func() {
@@ -2979,8 +4227,8 @@ var _ = Describe("Release adapter", Ordered, func() {
 			Expect(pipelineRun).NotTo(BeNil())
 			Expect(err).NotTo(HaveOccurred())
 
-			bundle := enterpriseContractConfigMap.Data["verify_ec_task_bundle"]
-			revision := enterpriseContractConfigMap.Data["verify_ec_task_git_revision"]
+			bundle := enterpriseContractConfigMap.Data[tektonutils.EnterpriseContractBundleKey]
+			revision := enterpriseContractConfigMap.Data[tektonutils.EnterpriseContractGitRevisionKey]
 			Expect(pipelineRun.Spec.Params).Should(ContainElement(HaveField("Value.StringVal", Equal(string(bundle)))))
 			Expect(pipelineRun.Spec.Params).Should(ContainElement(HaveField("Value.StringVal", Equal(string(revision)))))
 		})
@@ -2995,6 +4243,87 @@ var _ = Describe("Release adapter", Ordered, func() {
 			Expect(pipelineRun.Spec.Params).Should(ContainElement(HaveField("Value.StringVal", Equal(string(jsonSpec)))))
 		})
 
+		It("does not propagate the excluded Release annotations", func() {
+			adapter.release.Annotations = map[string]string{
+				metadata.PipelinesAsCodePrefix + "/event-type": "push",
+				metadata.PipelinesAsCodePrefix + "/internal":   "value",
+			}
+			adapter.pipelineRunConfig.AnnotationPrefixes = []string{metadata.PipelinesAsCodePrefix}
+			adapter.pipelineRunConfig.PipelinesAsCodeExcludedAnnotations = []string{metadata.PipelinesAsCodePrefix + "/internal"}
+
+			var err error
+			pipelineRun, err = adapter.createManagedPipelineRun(resources)
+			Expect(pipelineRun).NotTo(BeNil())
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(pipelineRun.Annotations).To(HaveKeyWithValue(metadata.PipelinesAsCodePrefix+"/event-type", "push"))
+			Expect(pipelineRun.Annotations).NotTo(HaveKey(metadata.PipelinesAsCodePrefix + "/internal"))
+		})
+
+		It("references a ConfigMap with the EnterpriseContractPolicy if its spec exceeds the maximum param size", func() {
+			adapter.pipelineRunConfig.EnterpriseContractPolicyMaxParamSize = 1
+
+			var err error
+			pipelineRun, err = adapter.createManagedPipelineRun(resources)
+			Expect(pipelineRun).NotTo(BeNil())
+			Expect(err).NotTo(HaveOccurred())
+
+			jsonSpec, _ := json.Marshal(enterpriseContractPolicy.Spec)
+			Expect(pipelineRun.Spec.Params).ShouldNot(ContainElement(HaveField("Value.StringVal", Equal(string(jsonSpec)))))
+
+			var configMapName string
+			for _, param := range pipelineRun.Spec.Params {
+				if param.Name == "policy_configmap" {
+					configMapName = param.Value.StringVal
+				}
+			}
+			Expect(configMapName).NotTo(BeEmpty())
+
+			configMap := &corev1.ConfigMap{}
+			Expect(k8sClient.Get(ctx, types.NamespacedName{
+				Name:      configMapName,
+				Namespace: pipelineRun.Namespace,
+			}, configMap)).To(Succeed())
+			Expect(configMap.Data).To(HaveKeyWithValue(enterpriseContractPolicyConfigMapKey, string(jsonSpec)))
+			Expect(configMap.OwnerReferences).To(ContainElement(HaveField("UID", pipelineRun.UID)))
+			Expect(k8sClient.Delete(ctx, configMap)).To(Succeed())
+		})
+
+		It("owns the EnterpriseContractPolicy ConfigMap even if the Release attempt can't be recorded", func() {
+			adapter.pipelineRunConfig.EnterpriseContractPolicyMaxParamSize = 1
+			deterministicReleasePlanAdmission := releasePlanAdmission.DeepCopy()
+			deterministicReleasePlanAdmission.Spec.DeterministicPipelineRunName = true
+			resources.ReleasePlanAdmission = deterministicReleasePlanAdmission
+
+			// Deleting the Release makes the status patch recording the attempt fail
+			Expect(k8sClient.Delete(ctx, adapter.release)).To(Succeed())
+
+			createdPipelineRun, err := adapter.createManagedPipelineRun(resources)
+			Expect(createdPipelineRun).To(BeNil())
+			Expect(errors.IsNotFound(err)).To(BeTrue())
+
+			configMaps := &corev1.ConfigMapList{}
+			Expect(k8sClient.List(ctx, configMaps,
+				client.InNamespace(deterministicReleasePlanAdmission.Namespace),
+				client.MatchingLabels{
+					metadata.ReleaseNameLabel:      metadata.SanitizeLabelValue(adapter.release.Name),
+					metadata.ReleaseNamespaceLabel: metadata.SanitizeLabelValue(adapter.release.Namespace),
+				})).To(Succeed())
+			Expect(configMaps.Items).To(HaveLen(1))
+
+			owner := metav1.GetControllerOf(&configMaps.Items[0])
+			Expect(owner).NotTo(BeNil())
+			Expect(owner.Kind).To(Equal("PipelineRun"))
+
+			pipelineRun = &tektonv1.PipelineRun{}
+			Expect(k8sClient.Get(ctx, types.NamespacedName{
+				Name:      owner.Name,
+				Namespace: configMaps.Items[0].Namespace,
+			}, pipelineRun)).To(Succeed())
+			Expect(owner.UID).To(Equal(pipelineRun.UID))
+			Expect(k8sClient.Delete(ctx, &configMaps.Items[0])).To(Succeed())
+		})
+
 		It("contains a workspace using EmptyDir if there's an override for the pipeline", func() {
 			url, revision, pathInRepo, err := releasePlanAdmission.Spec.Pipeline.PipelineRef.GetGitResolverParams()
 			Expect(err).To(BeNil())
@@ -3065,6 +4394,95 @@ var _ = Describe("Release adapter", Ordered, func() {
 			Expect(pipelineRun.Spec.Workspaces[0].VolumeClaimTemplate).NotTo(BeNil())
 			Expect(pipelineRun.Spec.Workspaces[0].EmptyDir).To(BeNil())
 		})
+
+		It("contains a read-only workspace backed by the signing Secret if one is set", func() {
+			newReleasePlanAdmission := releasePlanAdmission.DeepCopy()
+			newReleasePlanAdmission.Spec.Pipeline.SigningSecretWorkspace = &tektonutils.SecretWorkspace{
+				Name:       "signing-keys",
+				SecretName: "signing-secret",
+			}
+			resources.ReleasePlanAdmission = newReleasePlanAdmission
+
+			var err error
+			pipelineRun, err = adapter.createManagedPipelineRun(resources)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(pipelineRun.Spec.Workspaces).To(ContainElement(tektonv1.WorkspaceBinding{
+				Name:   "signing-keys",
+				Secret: &corev1.SecretVolumeSource{SecretName: "signing-secret"},
+			}))
+		})
+
+		It("uses the scheduling options defined in the Pipeline", func() {
+			newReleasePlanAdmission := releasePlanAdmission.DeepCopy()
+			newReleasePlanAdmission.Spec.Pipeline.PriorityClassName = "release-critical"
+			newReleasePlanAdmission.Spec.Pipeline.SchedulerName = "custom-scheduler"
+			resources.ReleasePlanAdmission = newReleasePlanAdmission
+
+			var err error
+			pipelineRun, err = adapter.createManagedPipelineRun(resources)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(*pipelineRun.Spec.TaskRunTemplate.PodTemplate.PriorityClassName).To(Equal("release-critical"))
+			Expect(pipelineRun.Spec.TaskRunTemplate.PodTemplate.SchedulerName).To(Equal("custom-scheduler"))
+		})
+
+		It("contains the additional workspaces defined in the Pipeline", func() {
+			newReleasePlanAdmission := releasePlanAdmission.DeepCopy()
+			newReleasePlanAdmission.Spec.Pipeline.Workspaces = []tektonutils.Workspace{
+				{Name: "config", ConfigMap: "my-config"},
+			}
+			resources.ReleasePlanAdmission = newReleasePlanAdmission
+
+			var err error
+			pipelineRun, err = adapter.createManagedPipelineRun(resources)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(pipelineRun.Spec.Workspaces).To(HaveLen(2))
+			Expect(pipelineRun.Spec.Workspaces[1].Name).To(Equal("config"))
+			Expect(pipelineRun.Spec.Workspaces[1].ConfigMap.Name).To(Equal("my-config"))
+		})
+
+		It("names the PipelineRun after the Release bumping the attempt if the name is taken", func() {
+			newReleasePlanAdmission := releasePlanAdmission.DeepCopy()
+			newReleasePlanAdmission.Spec.DeterministicPipelineRunName = true
+			resources.ReleasePlanAdmission = newReleasePlanAdmission
+
+			existingPipelineRun := &tektonv1.PipelineRun{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      adapter.release.Name + "-1",
+					Namespace: newReleasePlanAdmission.Namespace,
+				},
+			}
+			Expect(k8sClient.Create(ctx, existingPipelineRun)).To(Succeed())
+			defer func() {
+				Expect(k8sClient.Delete(ctx, existingPipelineRun)).To(Succeed())
+			}()
+
+			var err error
+			pipelineRun, err = adapter.createManagedPipelineRun(resources)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(pipelineRun.Name).To(Equal(adapter.release.Name + "-2"))
+			Expect(adapter.release.Status.ManagedProcessing.Attempt).To(Equal(2))
+		})
+
+		It("uses the workspace values from the PipelineRunConfig instead of the environment", func() {
+			Expect(os.Setenv("DEFAULT_RELEASE_WORKSPACE_NAME", "env-workspace")).To(Succeed())
+			Expect(os.Setenv("DEFAULT_RELEASE_WORKSPACE_SIZE", "5Gi")).To(Succeed())
+			defer func() {
+				Expect(os.Unsetenv("DEFAULT_RELEASE_WORKSPACE_NAME")).To(Succeed())
+				Expect(os.Unsetenv("DEFAULT_RELEASE_WORKSPACE_SIZE")).To(Succeed())
+			}()
+			adapter.pipelineRunConfig.WorkspaceName = "config-workspace"
+			adapter.pipelineRunConfig.WorkspaceSize = "2Gi"
+
+			var err error
+			pipelineRun, err = adapter.createManagedPipelineRun(resources)
+			Expect(pipelineRun).NotTo(BeNil())
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(pipelineRun.Spec.Workspaces).To(HaveLen(1))
+			Expect(pipelineRun.Spec.Workspaces[0].Name).To(Equal("config-workspace"))
+			Expect(pipelineRun.Spec.Workspaces[0].VolumeClaimTemplate).NotTo(BeNil())
+			Expect(pipelineRun.Spec.Workspaces[0].VolumeClaimTemplate.Spec.Resources.Requests.Storage().String()).To(Equal("2Gi"))
+		})
 	})
 
 	When("createFinalPipelineRun is called", func() {
@@ -3180,6 +4598,10 @@ var _ = Describe("Release adapter", Ordered, func() {
 				fmt.Sprintf("%s%c%s", snapshot.Namespace, types.Separator, snapshot.Name))))
 		})
 
+		It("has the snapshot spec", func() {
+			Expect(pipelineRun.Spec.Params).Should(ContainElement(HaveField("Name", "snapshot_spec")))
+		})
+
 		It("has owner annotations", func() {
 			Expect(pipelineRun.GetAnnotations()[handler.NamespacedNameAnnotation]).To(ContainSubstring(adapter.release.Name))
 			Expect(pipelineRun.GetAnnotations()[handler.TypeAnnotation]).To(ContainSubstring("Release"))
@@ -3617,6 +5039,143 @@ var _ = Describe("Release adapter", Ordered, func() {
 		})
 	})
 
+	When("queueManagedPipelineRunIfNeeded is called", func() {
+		var adapter *adapter
+		var newReleasePlanAdmission *v1alpha1.ReleasePlanAdmission
+		var queue string
+
+		AfterEach(func() {
+			_ = adapter.client.Delete(ctx, adapter.release)
+		})
+
+		BeforeEach(func() {
+			adapter = createReleaseAndAdapter()
+			adapter.release.MarkReleasing("")
+
+			newReleasePlanAdmission = releasePlanAdmission.DeepCopy()
+			newReleasePlanAdmission.Spec.PipelineConcurrencyLimit = 1
+			queue = fmt.Sprintf("%s/%s", newReleasePlanAdmission.Namespace, newReleasePlanAdmission.Name)
+		})
+
+		mockQueue := func(pipelineRuns []tektonv1.PipelineRun, releases []v1alpha1.Release) {
+			adapter.ctx = toolkit.GetMockedContext(ctx, []toolkit.MockData{
+				{
+					ContextKey: loader.ReleasePlanAdmissionPipelineRunsContextKey,
+					Resource:   &tektonv1.PipelineRunList{Items: pipelineRuns},
+				},
+				{
+					ContextKey: loader.QueuedReleasesContextKey,
+					Resource:   &v1alpha1.ReleaseList{Items: releases},
+				},
+			})
+		}
+
+		newQueuedRelease := func(name string, creationTime time.Time) v1alpha1.Release {
+			queuedRelease := v1alpha1.Release{
+				ObjectMeta: metav1.ObjectMeta{
+					CreationTimestamp: metav1.Time{Time: creationTime},
+					Name:              name,
+					Namespace:         "default",
+					UID:               types.UID(name),
+				},
+			}
+			queuedRelease.MarkReleasing("")
+			queuedRelease.MarkQueued(queue, "")
+			return queuedRelease
+		}
+
+		It("should not queue the Release if the ReleasePlanAdmission sets no limit", func() {
+			newReleasePlanAdmission.Spec.PipelineConcurrencyLimit = 0
+			mockQueue([]tektonv1.PipelineRun{{}}, nil)
+
+			queued, err := adapter.queueManagedPipelineRunIfNeeded(newReleasePlanAdmission)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(queued).To(BeFalse())
+			Expect(adapter.release.IsQueued()).To(BeFalse())
+		})
+
+		It("should not queue the Release if there is a free slot", func() {
+			finishedPipelineRun := tektonv1.PipelineRun{}
+			finishedPipelineRun.Status.MarkSucceeded("", "")
+			mockQueue([]tektonv1.PipelineRun{finishedPipelineRun}, nil)
+
+			queued, err := adapter.queueManagedPipelineRunIfNeeded(newReleasePlanAdmission)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(queued).To(BeFalse())
+			Expect(adapter.release.IsQueued()).To(BeFalse())
+		})
+
+		It("should queue the Release if the limit was reached", func() {
+			mockQueue([]tektonv1.PipelineRun{{}}, nil)
+
+			queued, err := adapter.queueManagedPipelineRunIfNeeded(newReleasePlanAdmission)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(queued).To(BeTrue())
+			Expect(adapter.release.IsQueued()).To(BeTrue())
+			Expect(adapter.release.Status.Queue).To(Equal(queue))
+		})
+
+		It("should dequeue the Release once a running PipelineRun completes", func() {
+			runningPipelineRun := tektonv1.PipelineRun{}
+			mockQueue([]tektonv1.PipelineRun{runningPipelineRun}, nil)
+
+			queued, err := adapter.queueManagedPipelineRunIfNeeded(newReleasePlanAdmission)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(queued).To(BeTrue())
+
+			runningPipelineRun.Status.MarkSucceeded("", "")
+			mockQueue([]tektonv1.PipelineRun{runningPipelineRun}, nil)
+
+			queued, err = adapter.queueManagedPipelineRunIfNeeded(newReleasePlanAdmission)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(queued).To(BeFalse())
+			Expect(adapter.release.IsQueued()).To(BeFalse())
+			Expect(adapter.release.Status.Queue).To(BeEmpty())
+		})
+
+		It("should keep the Release queued while an older Release is waiting in the same queue", func() {
+			olderRelease := newQueuedRelease("older-release", adapter.release.CreationTimestamp.Add(-time.Minute))
+			mockQueue(nil, []v1alpha1.Release{olderRelease})
+
+			queued, err := adapter.queueManagedPipelineRunIfNeeded(newReleasePlanAdmission)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(queued).To(BeTrue())
+			Expect(adapter.release.IsQueued()).To(BeTrue())
+		})
+
+		It("should not wait for the Releases queued after it", func() {
+			newerRelease := newQueuedRelease("newer-release", adapter.release.CreationTimestamp.Add(time.Minute))
+			mockQueue(nil, []v1alpha1.Release{newerRelease})
+
+			queued, err := adapter.queueManagedPipelineRunIfNeeded(newReleasePlanAdmission)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(queued).To(BeFalse())
+		})
+
+		It("should not wait for finished Releases left in the queue", func() {
+			olderRelease := newQueuedRelease("older-release", adapter.release.CreationTimestamp.Add(-time.Minute))
+			olderRelease.MarkReleaseFailed("")
+			mockQueue(nil, []v1alpha1.Release{olderRelease})
+
+			queued, err := adapter.queueManagedPipelineRunIfNeeded(newReleasePlanAdmission)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(queued).To(BeFalse())
+		})
+
+		It("should dequeue the Release if the ReleasePlanAdmission no longer sets a limit", func() {
+			mockQueue([]tektonv1.PipelineRun{{}}, nil)
+			queued, err := adapter.queueManagedPipelineRunIfNeeded(newReleasePlanAdmission)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(queued).To(BeTrue())
+
+			newReleasePlanAdmission.Spec.PipelineConcurrencyLimit = 0
+			queued, err = adapter.queueManagedPipelineRunIfNeeded(newReleasePlanAdmission)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(queued).To(BeFalse())
+			Expect(adapter.release.IsQueued()).To(BeFalse())
+		})
+	})
+
 	When("registerTenantCollectorsProcessingData is called", func() {
 		var adapter *adapter
 
@@ -3902,56 +5461,204 @@ var _ = Describe("Release adapter", Ordered, func() {
 			Expect(adapter.release.IsTenantPipelineProcessedSuccessfully()).To(BeTrue())
 		})
 
-		It("sets the Release as Tenant Processing failed if the PipelineRun didn't succeed", func() {
+		It("sets the Release as Tenant Processing failed if the PipelineRun didn't succeed", func() {
+			pipelineRun := &tektonv1.PipelineRun{}
+			pipelineRun.Status.MarkFailed("", "")
+			adapter.release.MarkTenantPipelineProcessing()
+
+			Expect(adapter.registerTenantProcessingStatus(pipelineRun)).To(Succeed())
+			Expect(adapter.release.HasTenantPipelineProcessingFinished()).To(BeTrue())
+			Expect(adapter.release.IsTenantPipelineProcessedSuccessfully()).To(BeFalse())
+		})
+	})
+
+	When("registerManagedProcessingStatus is called", func() {
+		var adapter *adapter
+
+		AfterEach(func() {
+			_ = adapter.client.Delete(ctx, adapter.release)
+		})
+
+		BeforeEach(func() {
+			adapter = createReleaseAndAdapter()
+		})
+
+		It("does nothing if there is no PipelineRun", func() {
+			Expect(adapter.registerManagedProcessingStatus(nil)).To(Succeed())
+			Expect(adapter.release.Status.ManagedProcessing.CompletionTime).To(BeNil())
+		})
+
+		It("does nothing if the PipelineRun is not done", func() {
+			pipelineRun := &tektonv1.PipelineRun{}
+			Expect(adapter.registerManagedProcessingStatus(pipelineRun)).To(Succeed())
+			Expect(adapter.release.Status.ManagedProcessing.CompletionTime).To(BeNil())
+		})
+
+		It("sets the Release as Managed Processed if the PipelineRun succeeded", func() {
+			pipelineRun := &tektonv1.PipelineRun{}
+			pipelineRun.Status.MarkSucceeded("", "")
+			adapter.release.MarkManagedPipelineProcessing()
+
+			Expect(adapter.registerManagedProcessingStatus(pipelineRun)).To(Succeed())
+			Expect(adapter.release.IsManagedPipelineProcessedSuccessfully()).To(BeTrue())
+		})
+
+		It("sets the Release as Managed Processing failed if the PipelineRun didn't succeed", func() {
+			pipelineRun := &tektonv1.PipelineRun{}
+			pipelineRun.Status.MarkFailed("", "")
+			adapter.release.MarkManagedPipelineProcessing()
+
+			Expect(adapter.registerManagedProcessingStatus(pipelineRun)).To(Succeed())
+			Expect(adapter.release.HasManagedPipelineProcessingFinished()).To(BeTrue())
+			Expect(adapter.release.IsManagedPipelineProcessedSuccessfully()).To(BeFalse())
+		})
+
+		It("surfaces the PipelineRun failure in the Release conditions", func() {
+			pipelineRun := &tektonv1.PipelineRun{}
+			pipelineRun.Status.MarkFailed("Failed", "Tasks Completed: 1 (Failed: 1)")
+			adapter.release.MarkReleasing("")
+			adapter.release.MarkManagedPipelineProcessing()
+
+			Expect(adapter.registerManagedProcessingStatus(pipelineRun)).To(Succeed())
+			Expect(adapter.release.IsFailed()).To(BeTrue())
+
+			condition := meta.FindStatusCondition(adapter.release.Status.Conditions, "ManagedPipelineProcessed")
+			Expect(condition).NotTo(BeNil())
+			Expect(condition.Message).To(Equal("Tasks Completed: 1 (Failed: 1)"))
+
+			condition = meta.FindStatusCondition(adapter.release.Status.Conditions, "Released")
+			Expect(condition).NotTo(BeNil())
+			Expect(condition.Message).To(Equal("Release processing failed on managed pipelineRun: Tasks Completed: 1 (Failed: 1)"))
+		})
+
+		It("records a PipelineRunFailed event with the failure message if the PipelineRun didn't succeed", func() {
+			pipelineRun := &tektonv1.PipelineRun{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "pipeline-run",
+					Namespace: "default",
+				},
+			}
+			pipelineRun.Status.MarkFailed("Failed", "Tasks Completed: 1 (Failed: 1)")
+			adapter.release.MarkReleasing("")
+			adapter.release.MarkManagedPipelineProcessing()
+
+			Expect(adapter.registerManagedProcessingStatus(pipelineRun)).To(Succeed())
+			eventRecorder := adapter.eventRecorder.(*record.FakeRecorder)
+			Expect(eventRecorder.Events).To(Receive(Equal("Warning " + pipelineRunFailedEventReason +
+				" managed PipelineRun default/pipeline-run failed: Tasks Completed: 1 (Failed: 1)")))
+		})
+
+		It("does not record any event if the PipelineRun succeeded", func() {
+			pipelineRun := &tektonv1.PipelineRun{}
+			pipelineRun.Status.MarkSucceeded("", "")
+			adapter.release.MarkManagedPipelineProcessing()
+
+			Expect(adapter.registerManagedProcessingStatus(pipelineRun)).To(Succeed())
+			eventRecorder := adapter.eventRecorder.(*record.FakeRecorder)
+			Expect(eventRecorder.Events).NotTo(Receive())
+		})
+
+		It("records the resolved Pipeline bundle in the Release", func() {
+			pipelineRun := &tektonv1.PipelineRun{}
+			pipelineRun.Status.MarkSucceeded("", "")
+			pipelineRun.Status.Provenance = &tektonv1.Provenance{
+				RefSource: &tektonv1.RefSource{
+					URI:    "quay.io/konflux-ci/release-pipeline",
+					Digest: map[string]string{"sha256": "abc"},
+				},
+			}
+			adapter.release.MarkManagedPipelineProcessing()
+
+			Expect(adapter.registerManagedProcessingStatus(pipelineRun)).To(Succeed())
+			Expect(adapter.release.Status.ManagedProcessing.PipelineBundle).To(Equal("quay.io/konflux-ci/release-pipeline@sha256:abc"))
+		})
+
+		It("stores the PipelineRun results as artifacts in the Release if the PipelineRun succeeded", func() {
 			pipelineRun := &tektonv1.PipelineRun{}
-			pipelineRun.Status.MarkFailed("", "")
-			adapter.release.MarkTenantPipelineProcessing()
+			pipelineRun.Status.MarkSucceeded("", "")
+			pipelineRun.Status.Results = []tektonv1.PipelineRunResult{
+				{Name: "advisory-url", Value: *tektonv1.NewStructuredValues("https://example.com/advisory")},
+				{Name: "released-images", Value: *tektonv1.NewStructuredValues("quay.io/a", "quay.io/b")},
+			}
+			adapter.release.MarkManagedPipelineProcessing()
 
-			Expect(adapter.registerTenantProcessingStatus(pipelineRun)).To(Succeed())
-			Expect(adapter.release.HasTenantPipelineProcessingFinished()).To(BeTrue())
-			Expect(adapter.release.IsTenantPipelineProcessedSuccessfully()).To(BeFalse())
-		})
-	})
+			Expect(adapter.registerManagedProcessingStatus(pipelineRun)).To(Succeed())
 
-	When("registerManagedProcessingStatus is called", func() {
-		var adapter *adapter
+			release := &v1alpha1.Release{}
+			Expect(k8sClient.Get(ctx, types.NamespacedName{
+				Name:      adapter.release.Name,
+				Namespace: adapter.release.Namespace,
+			}, release)).To(Succeed())
+			Expect(release.Status.Artifacts).NotTo(BeNil())
 
-		AfterEach(func() {
-			_ = adapter.client.Delete(ctx, adapter.release)
+			artifacts := map[string]interface{}{}
+			Expect(json.Unmarshal(release.Status.Artifacts.Raw, &artifacts)).To(Succeed())
+			Expect(artifacts).To(HaveKeyWithValue("advisory-url", "https://example.com/advisory"))
+			Expect(artifacts).To(HaveKeyWithValue("released-images", `["quay.io/a","quay.io/b"]`))
 		})
 
-		BeforeEach(func() {
-			adapter = createReleaseAndAdapter()
-		})
+		It("keeps the existing artifacts of the Release", func() {
+			pipelineRun := &tektonv1.PipelineRun{}
+			pipelineRun.Status.MarkSucceeded("", "")
+			pipelineRun.Status.Results = []tektonv1.PipelineRunResult{
+				{Name: "index-image", Value: *tektonv1.NewStructuredValues("quay.io/index")},
+			}
+			adapter.release.Status.Artifacts = &runtime.RawExtension{Raw: []byte(`{"foo":"bar"}`)}
+			adapter.release.MarkManagedPipelineProcessing()
 
-		It("does nothing if there is no PipelineRun", func() {
-			Expect(adapter.registerManagedProcessingStatus(nil)).To(Succeed())
-			Expect(adapter.release.Status.ManagedProcessing.CompletionTime).To(BeNil())
+			Expect(adapter.registerManagedProcessingStatus(pipelineRun)).To(Succeed())
+
+			artifacts := map[string]interface{}{}
+			Expect(json.Unmarshal(adapter.release.Status.Artifacts.Raw, &artifacts)).To(Succeed())
+			Expect(artifacts).To(HaveKeyWithValue("foo", "bar"))
+			Expect(artifacts).To(HaveKeyWithValue("index-image", "quay.io/index"))
 		})
 
-		It("does nothing if the PipelineRun is not done", func() {
+		It("truncates the artifacts exceeding the maximum artifact size", func() {
+			adapter.pipelineRunConfig.MaxArtifactSize = 5
+
 			pipelineRun := &tektonv1.PipelineRun{}
+			pipelineRun.Status.MarkSucceeded("", "")
+			pipelineRun.Status.Results = []tektonv1.PipelineRunResult{
+				{Name: "index-image", Value: *tektonv1.NewStructuredValues("quay.io/index")},
+			}
+			adapter.release.MarkManagedPipelineProcessing()
+
 			Expect(adapter.registerManagedProcessingStatus(pipelineRun)).To(Succeed())
-			Expect(adapter.release.Status.ManagedProcessing.CompletionTime).To(BeNil())
+
+			artifacts := map[string]interface{}{}
+			Expect(json.Unmarshal(adapter.release.Status.Artifacts.Raw, &artifacts)).To(Succeed())
+			Expect(artifacts).To(HaveKeyWithValue("index-image", "quay."+truncatedArtifactSuffix))
 		})
 
-		It("sets the Release as Managed Processed if the PipelineRun succeeded", func() {
+		It("truncates the artifacts on a rune boundary", func() {
+			adapter.pipelineRunConfig.MaxArtifactSize = 5
+
 			pipelineRun := &tektonv1.PipelineRun{}
 			pipelineRun.Status.MarkSucceeded("", "")
+			pipelineRun.Status.Results = []tektonv1.PipelineRunResult{
+				// The 3-byte euro sign starts at the 5th byte, so it would be split at the limit
+				{Name: "notes", Value: *tektonv1.NewStructuredValues("quay€index")},
+			}
 			adapter.release.MarkManagedPipelineProcessing()
 
 			Expect(adapter.registerManagedProcessingStatus(pipelineRun)).To(Succeed())
-			Expect(adapter.release.IsManagedPipelineProcessedSuccessfully()).To(BeTrue())
+
+			artifacts := map[string]interface{}{}
+			Expect(json.Unmarshal(adapter.release.Status.Artifacts.Raw, &artifacts)).To(Succeed())
+			Expect(artifacts).To(HaveKeyWithValue("notes", "quay"+truncatedArtifactSuffix))
 		})
 
-		It("sets the Release as Managed Processing failed if the PipelineRun didn't succeed", func() {
+		It("doesn't store artifacts if the PipelineRun failed", func() {
 			pipelineRun := &tektonv1.PipelineRun{}
 			pipelineRun.Status.MarkFailed("", "")
+			pipelineRun.Status.Results = []tektonv1.PipelineRunResult{
+				{Name: "index-image", Value: *tektonv1.NewStructuredValues("quay.io/index")},
+			}
 			adapter.release.MarkManagedPipelineProcessing()
 
 			Expect(adapter.registerManagedProcessingStatus(pipelineRun)).To(Succeed())
-			Expect(adapter.release.HasManagedPipelineProcessingFinished()).To(BeTrue())
-			Expect(adapter.release.IsManagedPipelineProcessedSuccessfully()).To(BeFalse())
+			Expect(adapter.release.Status.Artifacts).To(BeNil())
 		})
 	})
 
@@ -4291,6 +5998,90 @@ var _ = Describe("Release adapter", Ordered, func() {
 		})
 	})
 
+	When("validateEnterpriseContractConfigMap is called", func() {
+		var adapter *adapter
+
+		AfterEach(func() {
+			_ = adapter.client.Delete(ctx, adapter.release)
+		})
+
+		BeforeEach(func() {
+			adapter = createReleaseAndAdapter()
+		})
+
+		It("should return valid and no error if the ConfigMap defines all the required keys", func() {
+			adapter.ctx = toolkit.GetMockedContext(ctx, []toolkit.MockData{
+				{
+					ContextKey: loader.ReleasePlanContextKey,
+					Resource:   releasePlan,
+				},
+				{
+					ContextKey: loader.EnterpriseContractConfigMapContextKey,
+					Resource:   enterpriseContractConfigMap,
+				},
+			})
+
+			result := adapter.validateEnterpriseContractConfigMap()
+			Expect(result.Valid).To(BeTrue())
+			Expect(result.Err).NotTo(HaveOccurred())
+		})
+
+		It("should return valid and no error if the ConfigMap is not configured", func() {
+			adapter.ctx = toolkit.GetMockedContext(ctx, []toolkit.MockData{
+				{
+					ContextKey: loader.ReleasePlanContextKey,
+					Resource:   releasePlan,
+				},
+			})
+
+			result := adapter.validateEnterpriseContractConfigMap()
+			Expect(result.Valid).To(BeTrue())
+			Expect(result.Err).NotTo(HaveOccurred())
+		})
+
+		It("should return invalid and no error if the ConfigMap is missing a required key", func() {
+			newConfigMap := enterpriseContractConfigMap.DeepCopy()
+			delete(newConfigMap.Data, tektonutils.EnterpriseContractGitRevisionKey)
+			adapter.ctx = toolkit.GetMockedContext(ctx, []toolkit.MockData{
+				{
+					ContextKey: loader.ReleasePlanContextKey,
+					Resource:   releasePlan,
+				},
+				{
+					ContextKey: loader.EnterpriseContractConfigMapContextKey,
+					Resource:   newConfigMap,
+				},
+			})
+
+			result := adapter.validateEnterpriseContractConfigMap()
+			Expect(result.Valid).To(BeFalse())
+			Expect(result.Err).NotTo(HaveOccurred())
+			Expect(adapter.release.IsValid()).To(BeFalse())
+			Expect(meta.FindStatusCondition(adapter.release.Status.Conditions, "Validated").Message).To(
+				ContainSubstring(tektonutils.EnterpriseContractGitRevisionKey))
+		})
+
+		It("should return invalid and no error if a required key only contains whitespace", func() {
+			newConfigMap := enterpriseContractConfigMap.DeepCopy()
+			newConfigMap.Data[tektonutils.EnterpriseContractBundleKey] = "  "
+			adapter.ctx = toolkit.GetMockedContext(ctx, []toolkit.MockData{
+				{
+					ContextKey: loader.ReleasePlanContextKey,
+					Resource:   releasePlan,
+				},
+				{
+					ContextKey: loader.EnterpriseContractConfigMapContextKey,
+					Resource:   newConfigMap,
+				},
+			})
+
+			result := adapter.validateEnterpriseContractConfigMap()
+			Expect(result.Valid).To(BeFalse())
+			Expect(result.Err).NotTo(HaveOccurred())
+			Expect(adapter.release.IsValid()).To(BeFalse())
+		})
+	})
+
 	When("validatePipelineSource is called", func() {
 		var adapter *adapter
 
@@ -4401,6 +6192,66 @@ var _ = Describe("Release adapter", Ordered, func() {
 			Expect(adapter.release.IsValid()).To(BeFalse())
 		})
 
+		It("returns invalid and no error if the Tenant PipelineRef is ambiguous", func() {
+			adapter.ctx = toolkit.GetMockedContext(ctx, []toolkit.MockData{
+				{
+					ContextKey: loader.ReleasePlanContextKey,
+					Resource: &v1alpha1.ReleasePlan{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:      "release-plan",
+							Namespace: "default",
+						},
+						Spec: v1alpha1.ReleasePlanSpec{
+							TenantPipeline: &tektonutils.ParameterizedPipeline{
+								Pipeline: tektonutils.Pipeline{
+									PipelineRef: tektonutils.PipelineRef{
+										Resolver: "hub",
+										Params: []tektonutils.Param{
+											{Name: "name", Value: "release-pipeline"},
+											{Name: "bundle", Value: "my-bundle"},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			})
+			result := adapter.validatePipelineSource()
+			Expect(result.Valid).To(BeFalse())
+			Expect(result.Err).To(BeNil())
+			Expect(adapter.release.IsValid()).To(BeFalse())
+		})
+
+		It("returns valid if the Tenant PipelineRef is not ambiguous even if it misses required params", func() {
+			adapter.ctx = toolkit.GetMockedContext(ctx, []toolkit.MockData{
+				{
+					ContextKey: loader.ReleasePlanContextKey,
+					Resource: &v1alpha1.ReleasePlan{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:      "release-plan",
+							Namespace: "default",
+						},
+						Spec: v1alpha1.ReleasePlanSpec{
+							TenantPipeline: &tektonutils.ParameterizedPipeline{
+								Pipeline: tektonutils.Pipeline{
+									PipelineRef: tektonutils.PipelineRef{
+										Resolver: "hub",
+										Params: []tektonutils.Param{
+											{Name: "name", Value: "release-pipeline"},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			})
+			result := adapter.validatePipelineSource()
+			Expect(result.Valid).To(BeTrue())
+			Expect(result.Err).To(BeNil())
+		})
+
 		It("returns invalid and no error if debug is false and the Managed PipelineRef uses a cluster resolver", func() {
 			adapter.ctx = toolkit.GetMockedContext(ctx, []toolkit.MockData{
 				{
@@ -4794,6 +6645,110 @@ var _ = Describe("Release adapter", Ordered, func() {
 			Expect(result.Err).NotTo(HaveOccurred())
 		})
 
+		It("should wait for a missing ReleasePlanAdmission during the grace period", func() {
+			adapter.pipelineRunConfig.MissingReleasePlanAdmissionGracePeriod = time.Hour
+			adapter.ctx = toolkit.GetMockedContext(ctx, []toolkit.MockData{
+				{
+					ContextKey: loader.ReleasePlanAdmissionContextKey,
+					Err:        fmt.Errorf("%w in namespace (default) with the origin (default)", loader.ErrReleasePlanAdmissionNotFound),
+				},
+				{
+					ContextKey: loader.ReleasePlanContextKey,
+					Resource: &v1alpha1.ReleasePlan{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:      "release-plan",
+							Namespace: "default",
+						},
+						Spec: v1alpha1.ReleasePlanSpec{
+							Application: application.Name,
+							Target:      "default",
+						},
+					},
+				},
+			})
+
+			result := adapter.validatePipelineDefined()
+			Expect(result.Valid).To(BeFalse())
+			Expect(result.Err).NotTo(HaveOccurred())
+			Expect(adapter.release.IsReleasePlanAdmissionMissing()).To(BeTrue())
+		})
+
+		It("should validate the Release once a late ReleasePlanAdmission is created", func() {
+			adapter.pipelineRunConfig.MissingReleasePlanAdmissionGracePeriod = time.Hour
+			releasePlan := &v1alpha1.ReleasePlan{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "release-plan",
+					Namespace: "default",
+				},
+				Spec: v1alpha1.ReleasePlanSpec{
+					Application: application.Name,
+					Target:      "default",
+				},
+			}
+			adapter.ctx = toolkit.GetMockedContext(ctx, []toolkit.MockData{
+				{
+					ContextKey: loader.ReleasePlanAdmissionContextKey,
+					Err:        errors.NewNotFound(schema.GroupResource{}, ""),
+				},
+				{
+					ContextKey: loader.ReleasePlanContextKey,
+					Resource:   releasePlan,
+				},
+			})
+
+			result := adapter.validatePipelineDefined()
+			Expect(result.Valid).To(BeFalse())
+			Expect(adapter.release.IsReleasePlanAdmissionMissing()).To(BeTrue())
+
+			adapter.ctx = toolkit.GetMockedContext(ctx, []toolkit.MockData{
+				{
+					ContextKey: loader.ReleasePlanAdmissionContextKey,
+					Resource:   releasePlanAdmission,
+				},
+				{
+					ContextKey: loader.ReleasePlanContextKey,
+					Resource:   releasePlan,
+				},
+			})
+
+			result = adapter.validatePipelineDefined()
+			Expect(result.Valid).To(BeTrue())
+			Expect(result.Err).NotTo(HaveOccurred())
+		})
+
+		It("should fail the validation if the ReleasePlanAdmission is still missing after the grace period", func() {
+			adapter.pipelineRunConfig.MissingReleasePlanAdmissionGracePeriod = time.Hour
+			adapter.release.CreationTimestamp = metav1.NewTime(time.Now().Add(-2 * time.Hour))
+			adapter.ctx = toolkit.GetMockedContext(ctx, []toolkit.MockData{
+				{
+					ContextKey: loader.ReleasePlanAdmissionContextKey,
+					Err:        errors.NewNotFound(schema.GroupResource{}, ""),
+				},
+				{
+					ContextKey: loader.ReleasePlanContextKey,
+					Resource: &v1alpha1.ReleasePlan{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:      "release-plan",
+							Namespace: "default",
+						},
+						Spec: v1alpha1.ReleasePlanSpec{
+							Application: application.Name,
+							Target:      "default",
+						},
+					},
+				},
+			})
+
+			result := adapter.validatePipelineDefined()
+			Expect(result.Valid).To(BeFalse())
+			Expect(result.Err).NotTo(HaveOccurred())
+			Expect(adapter.release.IsReleasePlanAdmissionMissing()).To(BeFalse())
+
+			condition := meta.FindStatusCondition(adapter.release.Status.Conditions, "Validated")
+			Expect(condition).NotTo(BeNil())
+			Expect(condition.Reason).To(Equal(v1alpha1.FailedReason.String()))
+		})
+
 		It("should return false if ReleasePlan has no Pipeline Set and ReleasePlanAdmission is set to auto-release false", func() {
 			adapter.ctx = toolkit.GetMockedContext(ctx, []toolkit.MockData{
 				{
@@ -4885,7 +6840,7 @@ var _ = Describe("Release adapter", Ordered, func() {
 		Expect(k8sClient.Create(ctx, release)).To(Succeed())
 		release.Kind = "Release"
 
-		return newAdapter(ctx, k8sClient, release, loader.NewMockLoader(), &ctrl.Log)
+		return newAdapter(ctx, k8sClient, k8sClient, record.NewFakeRecorder(10), release, loader.NewMockLoader(), &ctrl.Log, pipelineRunConfig)
 	}
 
 	createResources = func() {
@@ -4912,16 +6867,8 @@ var _ = Describe("Release adapter", Ordered, func() {
 		}
 		Expect(k8sClient.Create(ctx, component)).Should(Succeed())
 
-		enterpriseContractConfigMap = &corev1.ConfigMap{
-			ObjectMeta: metav1.ObjectMeta{
-				Name:      "enterprise-contract-cm",
-				Namespace: "default",
-			},
-			Data: map[string]string{
-				"verify_ec_task_bundle":       "test-bundle",
-				"verify_ec_task_git_revision": "main",
-			},
-		}
+		enterpriseContractConfigMap = tektonutils.NewEnterpriseContractConfigMap("enterprise-contract-cm", "default",
+			"test-bundle", "main")
 		Expect(k8sClient.Create(ctx, enterpriseContractConfigMap)).Should(Succeed())
 
 		enterpriseContractPolicy = &ecapiv1alpha1.EnterpriseContractPolicy{
@@ -5034,6 +6981,14 @@ var _ = Describe("Release adapter", Ordered, func() {
 		}
 		Expect(k8sClient.Create(ctx, roleBinding)).To(Succeed())
 
+		serviceAccount = &corev1.ServiceAccount{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "service-account",
+				Namespace: "default",
+			},
+		}
+		Expect(k8sClient.Create(ctx, serviceAccount)).To(Succeed())
+
 		snapshot = &applicationapiv1alpha1.Snapshot{
 			ObjectMeta: metav1.ObjectMeta{
 				Name:      "snapshot",
@@ -5055,6 +7010,7 @@ var _ = Describe("Release adapter", Ordered, func() {
 		Expect(k8sClient.Delete(ctx, releasePlan)).To(Succeed())
 		Expect(k8sClient.Delete(ctx, releasePlanAdmission)).Should(Succeed())
 		Expect(k8sClient.Delete(ctx, releaseServiceConfig)).Should(Succeed())
+		Expect(k8sClient.Delete(ctx, serviceAccount)).To(Succeed())
 		Expect(k8sClient.Delete(ctx, snapshot)).To(Succeed())
 	}
 
@@ -27,11 +27,13 @@ import (
 	"github.com/go-logr/logr"
 	"github.com/konflux-ci/release-service/api/v1alpha1"
 	"github.com/konflux-ci/release-service/cache"
+	"github.com/konflux-ci/release-service/controllers/utils/handlers"
+	releasepredicates "github.com/konflux-ci/release-service/controllers/utils/predicates"
 	"github.com/konflux-ci/release-service/loader"
 	"github.com/konflux-ci/release-service/tekton"
-	libhandler "github.com/operator-framework/operator-lib/handler"
+	"github.com/konflux-ci/release-service/tekton/utils"
 	"k8s.io/apimachinery/pkg/api/errors"
-	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/tools/record"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -40,8 +42,13 @@ import (
 
 // Controller reconciles a Release object
 type Controller struct {
-	client client.Client
-	log    logr.Logger
+	apiReader     client.Reader
+	client        client.Client
+	eventRecorder record.EventRecorder
+	log           logr.Logger
+
+	// PipelineRunConfig contains the defaults to use for the release PipelineRuns
+	PipelineRunConfig utils.PipelineRunConfig
 }
 
 //+kubebuilder:rbac:groups=appstudio.redhat.com,resources=releases,verbs=get;list;watch;create;update;patch;delete
@@ -51,7 +58,9 @@ type Controller struct {
 //+kubebuilder:rbac:groups=appstudio.redhat.com,resources=enterprisecontractpolicies,verbs=get;list;watch
 //+kubebuilder:rbac:groups=appstudio.redhat.com,resources=enterprisecontractpolicies/status,verbs=get
 //+kubebuilder:rbac:groups=appstudio.redhat.com,resources=releaseserviceconfigs,verbs=get;list;watch
-//+kubebuilder:rbac:groups=core,resources=configmaps,verbs=get;list;watch
+//+kubebuilder:rbac:groups=core,resources=configmaps,verbs=get;list;watch;create;patch;delete
+//+kubebuilder:rbac:groups=core,resources=events,verbs=create;patch
+//+kubebuilder:rbac:groups=core,resources=serviceaccounts,verbs=get;list;watch
 //+kubebuilder:rbac:groups=rbac.authorization.k8s.io,resources=rolebindings,verbs=get;list;watch;create;update;patch;delete
 //+kubebuilder:rbac:groups=rbac.authorization.k8s.io,resources=roles,verbs=get;list;watch;create;update;patch;delete
 //+kubebuilder:rbac:groups="",resources=secrets,verbs=get
@@ -74,11 +83,14 @@ func (c *Controller) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Resu
 		return ctrl.Result{}, err
 	}
 
-	adapter := newAdapter(ctx, c.client, release, loader.NewLoader(), &logger)
+	adapter := newAdapter(ctx, c.client, c.apiReader, c.eventRecorder, release, loader.NewLoader(), &logger, c.PipelineRunConfig)
 
 	return controller.ReconcileHandler([]controller.Operation{
 		adapter.EnsureFinalizersAreCalled,
 		adapter.EnsureConfigIsLoaded, // This operation sets the config in the adapter to be used in other operations.
+		adapter.EnsureReleaseIsRetried,
+		adapter.EnsureReleasePipelineRunsAreGarbageCollected,
+		adapter.EnsureExpiredReleaseIsDeleted,
 		adapter.EnsureReleaseIsRunning,
 		adapter.EnsureReleaseIsValid,
 		adapter.EnsureApplicationMetadataIsSet,
@@ -97,24 +109,27 @@ func (c *Controller) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Resu
 		adapter.EnsureFinalPipelineProcessingIsTracked,
 		adapter.EnsureReleaseProcessingResourcesAreCleanedUp,
 		adapter.EnsureReleaseIsCompleted,
+		adapter.EnsureReleaseProcessingDeadlineIsEnforced,
 	})
 }
 
 // Register registers the controller with the passed manager and log. This controller ignores Release status updates and
 // also watches for PipelineRuns and SnapshotEnvironmentBindings that are created by the adapter and owned by the
-// Releases so the owner gets reconciled on changes.
+// Releases so the owner gets reconciled on changes. ReleasePlanAdmissions are watched as well, so the Releases waiting
+// for one to be created are reconciled as soon as it appears.
 func (c *Controller) Register(mgr ctrl.Manager, log *logr.Logger, _ cluster.Cluster) error {
+	c.apiReader = mgr.GetAPIReader()
 	c.client = mgr.GetClient()
+	c.eventRecorder = mgr.GetEventRecorderFor("release-controller")
 	c.log = log.WithName("release")
 
 	return ctrl.NewControllerManagedBy(mgr).
-		For(&v1alpha1.Release{}, builder.WithPredicates(predicate.GenerationChangedPredicate{}, predicates.IgnoreBackups{})).
-		Watches(&tektonv1.PipelineRun{}, &libhandler.EnqueueRequestForAnnotation[client.Object]{
-			Type: schema.GroupKind{
-				Kind:  "Release",
-				Group: "appstudio.redhat.com",
-			},
-		}, builder.WithPredicates(tekton.ReleasePipelineRunSucceededPredicate())).
+		For(&v1alpha1.Release{}, builder.WithPredicates(
+			predicate.Or(predicate.GenerationChangedPredicate{}, releasepredicates.RetryPredicate()),
+			predicates.IgnoreBackups{})).
+		Watches(&tektonv1.PipelineRun{}, &handlers.EnqueueRequestForReleaseOwner[client.Object]{},
+			builder.WithPredicates(tekton.ReleasePipelineRunSucceededPredicate())).
+		Watches(&v1alpha1.ReleasePlanAdmission{}, &handlers.EnqueueRequestForWaitingReleases[client.Object]{Reader: c.client}).
 		Complete(c)
 }
 
@@ -0,0 +1,114 @@
+/*
+Copyright 2023.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package release
+
+import (
+	"github.com/konflux-ci/release-service/api/v1alpha1"
+	"github.com/konflux-ci/release-service/loader"
+	"github.com/konflux-ci/release-service/metadata"
+	"github.com/konflux-ci/release-service/tekton/utils"
+	tektonv1 "github.com/tektoncd/pipeline/pkg/apis/pipeline/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// RenderManagedPipelineRun returns the managed PipelineRun the given Release would produce for the given processing
+// resources without creating it or mutating any other resource in the cluster, so it can be used to preview a Release.
+// As no ConfigMap is created for it, the EnterpriseContractPolicy is always passed inline as a param, even when the
+// reconciler would store it in a ConfigMap because it exceeds the maximum param size.
+func RenderManagedPipelineRun(release *v1alpha1.Release, resources *loader.ProcessingResources,
+	releaseServiceConfig *v1alpha1.ReleaseServiceConfig, pipelineRunConfig utils.PipelineRunConfig,
+	scheme *runtime.Scheme) (*tektonv1.PipelineRun, error) {
+	return newManagedPipelineRunBuilder(release, resources, releaseServiceConfig, pipelineRunConfig, scheme, nil).Build()
+}
+
+// newManagedPipelineRunBuilder returns a PipelineRunBuilder composing the managed PipelineRun for the given Release and
+// processing resources in the same order used by the reconciler. If a policy ConfigMap is passed, the
+// EnterpriseContractPolicy is referenced through it instead of being passed inline.
+func newManagedPipelineRunBuilder(release *v1alpha1.Release, resources *loader.ProcessingResources,
+	releaseServiceConfig *v1alpha1.ReleaseServiceConfig, pipelineRunConfig utils.PipelineRunConfig,
+	scheme *runtime.Scheme, policyConfigMap *corev1.ConfigMap) *utils.PipelineRunBuilder {
+	builder := utils.NewPipelineRunBuilder(metadata.ManagedPipelineType.String(), resources.ReleasePlanAdmission.Namespace).
+		// Params are added first so the ones generated by the service take precedence over them
+		WithRequiredParamsFromConfigMap(resources.EnterpriseContractConfigMap, utils.EnterpriseContractConfigMapKeys...).
+		WithAnnotationsWithPrefixes(release, pipelineRunConfig.AnnotationPrefixes,
+			pipelineRunConfig.PipelinesAsCodeExcludedAnnotations...).
+		WithApplicationSnapshot(resources.Snapshot).
+		WithData(resources.ReleasePlanAdmission.Spec.Data, release.Spec.Data).
+		WithDefaults(pipelineRunConfig).
+		WithFinalizerOptions(metadata.ReleaseFinalizer, utils.FinalizerOptions{
+			SkipFinalizer: pipelineRunConfig.SkipPipelineRunFinalizer,
+		}).
+		WithLabels(map[string]string{
+			metadata.ApplicationNameLabel:      metadata.SanitizeLabelValue(resources.ReleasePlan.Spec.Application),
+			metadata.AuthorLabel:               metadata.SanitizeLabelValue(release.Status.Attribution.Author),
+			metadata.PipelinesTypeLabel:        metadata.ManagedPipelineType.String(),
+			metadata.ServiceNameLabel:          metadata.ServiceName,
+			metadata.ReleaseNameLabel:          metadata.SanitizeLabelValue(release.Name),
+			metadata.ReleaseNamespaceLabel:     metadata.SanitizeLabelValue(release.Namespace),
+			metadata.ReleasePlanAdmissionLabel: metadata.SanitizeLabelValue(resources.ReleasePlanAdmission.Name),
+			metadata.ReleaseSnapshotLabel:      metadata.SanitizeLabelValue(release.Spec.Snapshot),
+		}).
+		WithObjectReferences(release, resources.ReleasePlan, resources.ReleasePlanAdmission, releaseServiceConfig,
+			resources.Snapshot).
+		WithOwner(release).
+		WithOwnerReference(release, scheme).
+		WithPipelineRef(resources.ReleasePlanAdmission.Spec.Pipeline.PipelineRef.ToTektonPipelineRef()).
+		WithPipelinesAsCodeAnnotations(release, pipelineRunConfig.PipelinesAsCodeExcludedAnnotations...).
+		WithPodTemplate(resources.ReleasePlanAdmission.Spec.Pipeline.NodeSelector, resources.ReleasePlanAdmission.Spec.Pipeline.Tolerations).
+		WithSchedulingOptions(resources.ReleasePlanAdmission.Spec.Pipeline.PriorityClassName, resources.ReleasePlanAdmission.Spec.Pipeline.SchedulerName).
+		WithServiceAccount(resources.ReleasePlanAdmission.Spec.Pipeline.ServiceAccountName).
+		WithTaskRunSpecs(resources.ReleasePlanAdmission.Spec.Pipeline.TaskRunSpecs...).
+		WithTimeouts(&resources.ReleasePlanAdmission.Spec.Pipeline.Timeouts, &releaseServiceConfig.Spec.DefaultTimeouts)
+
+	if policyConfigMap != nil {
+		builder.WithParams(tektonv1.Param{
+			Name: "policy_configmap",
+			Value: tektonv1.ParamValue{
+				Type:      tektonv1.ParamTypeString,
+				StringVal: policyConfigMap.Name,
+			},
+		})
+	} else {
+		builder.WithObjectSpecsAsJson(resources.EnterpriseContractPolicy)
+	}
+
+	url, revision, pathInRepo, err := resources.ReleasePlanAdmission.Spec.Pipeline.PipelineRef.GetGitResolverParams()
+	if err == nil && releaseServiceConfig.IsPipelineOverridden(url, revision, pathInRepo) {
+		builder.WithEmptyDirVolume(
+			pipelineRunConfig.WorkspaceName,
+			resources.ReleasePlanAdmission.Spec.Pipeline.GetWorkspaceSize(pipelineRunConfig.WorkspaceSize),
+		)
+	} else {
+		builder.WithWorkspaceFromVolumeTemplate(
+			pipelineRunConfig.WorkspaceName,
+			resources.ReleasePlanAdmission.Spec.Pipeline.GetWorkspaceSize(pipelineRunConfig.WorkspaceSize),
+			resources.ReleasePlanAdmission.Spec.Pipeline.StorageClass,
+		)
+	}
+
+	if signingSecretWorkspace := resources.ReleasePlanAdmission.Spec.Pipeline.SigningSecretWorkspace; signingSecretWorkspace != nil {
+		builder.WithSecretWorkspace(signingSecretWorkspace.Name, signingSecretWorkspace.SecretName)
+	}
+	builder.WithWorkspaces(resources.ReleasePlanAdmission.Spec.Pipeline.Workspaces...)
+
+	if resources.ReleasePlanAdmission.Spec.DeterministicPipelineRunName {
+		builder.WithDeterministicName(release.Name, release.Status.ManagedProcessing.Attempt+1)
+	}
+
+	return builder
+}
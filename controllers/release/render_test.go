@@ -0,0 +1,212 @@
+/*
+Copyright 2023.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package release
+
+import (
+	"strings"
+
+	ecapiv1alpha1 "github.com/conforma/crds/api/v1alpha1"
+	applicationapiv1alpha1 "github.com/konflux-ci/application-api/api/v1alpha1"
+	"github.com/konflux-ci/release-service/api/v1alpha1"
+	"github.com/konflux-ci/release-service/loader"
+	"github.com/konflux-ci/release-service/metadata"
+	tektonutils "github.com/konflux-ci/release-service/tekton/utils"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	tektonv1 "github.com/tektoncd/pipeline/pkg/apis/pipeline/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var _ = Describe("Release renderer", func() {
+	When("RenderManagedPipelineRun is called", func() {
+		var (
+			pipelineRunConfig    tektonutils.PipelineRunConfig
+			release              *v1alpha1.Release
+			releaseServiceConfig *v1alpha1.ReleaseServiceConfig
+			resources            *loader.ProcessingResources
+		)
+
+		BeforeEach(func() {
+			pipelineRunConfig = tektonutils.PipelineRunConfig{
+				WorkspaceName: "release-workspace",
+				WorkspaceSize: "1Gi",
+			}
+			release = &v1alpha1.Release{
+				TypeMeta: metav1.TypeMeta{
+					Kind: "Release",
+				},
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "release",
+					Namespace: "tenant",
+				},
+				Spec: v1alpha1.ReleaseSpec{
+					Snapshot:    "snapshot",
+					ReleasePlan: "release-plan",
+				},
+			}
+			releaseServiceConfig = &v1alpha1.ReleaseServiceConfig{
+				TypeMeta: metav1.TypeMeta{
+					Kind: "ReleaseServiceConfig",
+				},
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      v1alpha1.ReleaseServiceConfigResourceName,
+					Namespace: "release-service",
+				},
+			}
+			resources = &loader.ProcessingResources{
+				EnterpriseContractPolicy: &ecapiv1alpha1.EnterpriseContractPolicy{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "enterprise-contract-policy",
+						Namespace: "managed",
+					},
+					Spec: ecapiv1alpha1.EnterpriseContractPolicySpec{
+						Sources: []ecapiv1alpha1.Source{
+							{Name: "foo"},
+						},
+					},
+				},
+				ReleasePlan: &v1alpha1.ReleasePlan{
+					TypeMeta: metav1.TypeMeta{
+						Kind: "ReleasePlan",
+					},
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "release-plan",
+						Namespace: "tenant",
+					},
+					Spec: v1alpha1.ReleasePlanSpec{
+						Application: "application",
+						Target:      "managed",
+					},
+				},
+				ReleasePlanAdmission: &v1alpha1.ReleasePlanAdmission{
+					TypeMeta: metav1.TypeMeta{
+						Kind: "ReleasePlanAdmission",
+					},
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "release-plan-admission",
+						Namespace: "managed",
+					},
+					Spec: v1alpha1.ReleasePlanAdmissionSpec{
+						Applications: []string{"application"},
+						Origin:       "tenant",
+						Pipeline: &tektonutils.Pipeline{
+							PipelineRef: tektonutils.PipelineRef{
+								Resolver: "git",
+								Params: []tektonutils.Param{
+									{Name: "url", Value: "my-url"},
+									{Name: "revision", Value: "my-revision"},
+									{Name: "pathInRepo", Value: "my-path"},
+								},
+							},
+							ServiceAccountName: "service-account",
+						},
+						Policy: "enterprise-contract-policy",
+					},
+				},
+				Snapshot: &applicationapiv1alpha1.Snapshot{
+					TypeMeta: metav1.TypeMeta{
+						Kind: "Snapshot",
+					},
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "snapshot",
+						Namespace: "tenant",
+					},
+					Spec: applicationapiv1alpha1.SnapshotSpec{
+						Application: "application",
+					},
+				},
+			}
+		})
+
+		It("renders the managed PipelineRun in the managed namespace", func() {
+			pipelineRun, err := RenderManagedPipelineRun(release, resources, releaseServiceConfig, pipelineRunConfig,
+				k8sClient.Scheme())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(pipelineRun.Namespace).To(Equal("managed"))
+			Expect(pipelineRun.GenerateName).To(HavePrefix(metadata.ManagedPipelineType.String()))
+		})
+
+		It("renders the expected labels", func() {
+			pipelineRun, err := RenderManagedPipelineRun(release, resources, releaseServiceConfig, pipelineRunConfig,
+				k8sClient.Scheme())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(pipelineRun.Labels).To(HaveKeyWithValue(metadata.ApplicationNameLabel, "application"))
+			Expect(pipelineRun.Labels).To(HaveKeyWithValue(metadata.PipelinesTypeLabel, metadata.ManagedPipelineType.String()))
+			Expect(pipelineRun.Labels).To(HaveKeyWithValue(metadata.ReleaseNameLabel, "release"))
+			Expect(pipelineRun.Labels).To(HaveKeyWithValue(metadata.ReleaseNamespaceLabel, "tenant"))
+			Expect(pipelineRun.Labels).To(HaveKeyWithValue(metadata.ReleasePlanAdmissionLabel, "release-plan-admission"))
+			Expect(pipelineRun.Labels).To(HaveKeyWithValue(metadata.ReleaseSnapshotLabel, "snapshot"))
+		})
+
+		It("sanitizes the label values that are not valid labels", func() {
+			release.Spec.Snapshot = strings.Repeat("snapshot", 10)
+			resources.ReleasePlanAdmission.Name = strings.Repeat("release-plan-admission", 3)
+
+			pipelineRun, err := RenderManagedPipelineRun(release, resources, releaseServiceConfig, pipelineRunConfig,
+				k8sClient.Scheme())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(pipelineRun.Labels).To(HaveKeyWithValue(metadata.ReleasePlanAdmissionLabel,
+				metadata.SanitizeLabelValue(resources.ReleasePlanAdmission.Name)))
+			Expect(pipelineRun.Labels).To(HaveKeyWithValue(metadata.ReleaseSnapshotLabel,
+				metadata.SanitizeLabelValue(release.Spec.Snapshot)))
+			Expect(len(pipelineRun.Labels[metadata.ReleaseSnapshotLabel])).To(BeNumerically("<=", metadata.MaxLabelLength))
+		})
+
+		It("renders the expected params", func() {
+			pipelineRun, err := RenderManagedPipelineRun(release, resources, releaseServiceConfig, pipelineRunConfig,
+				k8sClient.Scheme())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(pipelineRun.Spec.Params).To(ContainElements(
+				tektonv1.Param{Name: "release", Value: tektonv1.ParamValue{Type: tektonv1.ParamTypeString, StringVal: "tenant/release"}},
+				tektonv1.Param{Name: "releasePlan", Value: tektonv1.ParamValue{Type: tektonv1.ParamTypeString, StringVal: "tenant/release-plan"}},
+				tektonv1.Param{Name: "releasePlanAdmission", Value: tektonv1.ParamValue{Type: tektonv1.ParamTypeString, StringVal: "managed/release-plan-admission"}},
+				tektonv1.Param{Name: "snapshot", Value: tektonv1.ParamValue{Type: tektonv1.ParamTypeString, StringVal: "tenant/snapshot"}},
+			))
+			Expect(pipelineRun.Spec.Params).To(ContainElement(HaveField("Name", "enterpriseContractPolicy")))
+		})
+
+		It("renders the pipelineRef from the ReleasePlanAdmission", func() {
+			pipelineRun, err := RenderManagedPipelineRun(release, resources, releaseServiceConfig, pipelineRunConfig,
+				k8sClient.Scheme())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(pipelineRun.Spec.PipelineRef).To(Equal(resources.ReleasePlanAdmission.Spec.Pipeline.PipelineRef.ToTektonPipelineRef()))
+			Expect(pipelineRun.Spec.TaskRunTemplate.ServiceAccountName).To(Equal("service-account"))
+		})
+
+		It("renders a deterministic name for the next attempt if the ReleasePlanAdmission requests it", func() {
+			resources.ReleasePlanAdmission.Spec.DeterministicPipelineRunName = true
+			release.Status.ManagedProcessing.Attempt = 1
+
+			pipelineRun, err := RenderManagedPipelineRun(release, resources, releaseServiceConfig, pipelineRunConfig,
+				k8sClient.Scheme())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(pipelineRun.Name).To(Equal("release-2"))
+			Expect(pipelineRun.GenerateName).To(BeEmpty())
+		})
+
+		It("does not mutate the given resources", func() {
+			releaseCopy := release.DeepCopy()
+			releasePlanAdmissionCopy := resources.ReleasePlanAdmission.DeepCopy()
+
+			_, err := RenderManagedPipelineRun(release, resources, releaseServiceConfig, pipelineRunConfig,
+				k8sClient.Scheme())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(release).To(Equal(releaseCopy))
+			Expect(resources.ReleasePlanAdmission).To(Equal(releasePlanAdmissionCopy))
+		})
+	})
+})
@@ -0,0 +1,172 @@
+/*
+Copyright 2023.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package snapshot
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	"github.com/go-logr/logr"
+	applicationapiv1alpha1 "github.com/konflux-ci/application-api/api/v1alpha1"
+	"github.com/konflux-ci/operator-toolkit/controller"
+	"github.com/konflux-ci/release-service/api/v1alpha1"
+	"github.com/konflux-ci/release-service/loader"
+	"github.com/konflux-ci/release-service/metadata"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// testSucceededConditionType is the type of the Snapshot condition set by the integration service once all the tests
+// for the Snapshot have passed.
+const testSucceededConditionType = "AppStudioTestSucceeded"
+
+// automatedReleaseNameHashLength is the number of hash characters used to make automated Release names unique.
+const automatedReleaseNameHashLength = 10
+
+// adapter holds the objects needed to reconcile a Snapshot.
+type adapter struct {
+	client   client.Client
+	ctx      context.Context
+	loader   loader.ObjectLoader
+	logger   *logr.Logger
+	snapshot *applicationapiv1alpha1.Snapshot
+}
+
+// newAdapter creates and returns an adapter instance.
+func newAdapter(ctx context.Context, client client.Client, snapshot *applicationapiv1alpha1.Snapshot, loader loader.ObjectLoader, logger *logr.Logger) *adapter {
+	return &adapter{
+		client:   client,
+		ctx:      ctx,
+		loader:   loader,
+		logger:   logger,
+		snapshot: snapshot,
+	}
+}
+
+// EnsureAutomatedReleasesAreCreated is an operation that will ensure that an automated Release is created for each of
+// the ReleasePlans with auto-release enabled for the Snapshot application once all the tests for the Snapshot have
+// passed. ReleasePlans that already have a Release for the Snapshot are skipped. As the Releases are listed from the
+// cache, the automated Releases get a deterministic name so a Release missing from the cache is not created twice.
+// Automated Releases whose status couldn't be marked as automated after being created are marked again.
+func (a *adapter) EnsureAutomatedReleasesAreCreated() (controller.OperationResult, error) {
+	if !a.snapshot.DeletionTimestamp.IsZero() || !meta.IsStatusConditionTrue(a.snapshot.Status.Conditions, testSucceededConditionType) {
+		return controller.ContinueProcessing()
+	}
+
+	releasePlans, err := a.loader.GetAutoReleasePlans(a.ctx, a.client, a.snapshot)
+	if err != nil {
+		return controller.RequeueWithError(err)
+	}
+
+	releases, err := a.loader.GetSnapshotReleases(a.ctx, a.client, a.snapshot)
+	if err != nil {
+		return controller.RequeueWithError(err)
+	}
+
+	releasedPlans := make(map[string]bool)
+	for i := range releases.Items {
+		release := &releases.Items[i]
+		releasedPlans[release.Spec.ReleasePlan] = true
+
+		err = a.markReleaseAsAutomated(release)
+		if err != nil {
+			return controller.RequeueWithError(err)
+		}
+	}
+
+	for i := range releasePlans.Items {
+		releasePlan := &releasePlans.Items[i]
+		if releasedPlans[releasePlan.Name] {
+			continue
+		}
+
+		release, err := a.createAutomatedRelease(releasePlan)
+		if err != nil {
+			return controller.RequeueWithError(err)
+		}
+
+		a.logger.Info("Created automated Release",
+			"Release.Name", release.Name, "ReleasePlan.Name", releasePlan.Name)
+	}
+
+	return controller.ContinueProcessing()
+}
+
+// createAutomatedRelease creates a Release for the Snapshot being processed and the given ReleasePlan. The Release is
+// marked as automated through its labels and status, so it's attributed to the ReleasePlan author. If the Release
+// already exists, it's fetched and its status is marked as automated if that's still missing.
+func (a *adapter) createAutomatedRelease(releasePlan *v1alpha1.ReleasePlan) (*v1alpha1.Release, error) {
+	release := &v1alpha1.Release{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      a.getAutomatedReleaseName(releasePlan),
+			Namespace: a.snapshot.Namespace,
+			Labels: map[string]string{
+				metadata.AutomatedLabel: "true",
+			},
+		},
+		Spec: v1alpha1.ReleaseSpec{
+			ReleasePlan: releasePlan.Name,
+			Snapshot:    a.snapshot.Name,
+		},
+	}
+
+	err := a.client.Create(a.ctx, release)
+	if errors.IsAlreadyExists(err) {
+		// The Release was created in a previous reconcile but it wasn't in the cache yet
+		err = a.client.Get(a.ctx, client.ObjectKeyFromObject(release), release)
+		if err == nil && (release.Spec.Snapshot != a.snapshot.Name || release.Spec.ReleasePlan != releasePlan.Name) {
+			err = fmt.Errorf("release %s already exists but it doesn't release snapshot %s with releasePlan %s",
+				release.Name, a.snapshot.Name, releasePlan.Name)
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return release, a.markReleaseAsAutomated(release)
+}
+
+// getAutomatedReleaseName returns the name of the automated Release for the Snapshot being processed and the given
+// ReleasePlan. The name is the ReleasePlan name followed by a short hash of both names, kept within the label value
+// length as Release names are also used as label values.
+func (a *adapter) getAutomatedReleaseName(releasePlan *v1alpha1.ReleasePlan) string {
+	hash := sha256.Sum256([]byte(releasePlan.Name + "/" + a.snapshot.Name))
+	prefix := releasePlan.Name
+	if maxPrefixLength := metadata.MaxLabelLength - automatedReleaseNameHashLength - 1; len(prefix) > maxPrefixLength {
+		prefix = strings.TrimRight(prefix[:maxPrefixLength], ".-")
+	}
+
+	return prefix + "-" + hex.EncodeToString(hash[:])[:automatedReleaseNameHashLength]
+}
+
+// markReleaseAsAutomated marks the status of the given Release as automated if it has the automated label but its
+// status was not marked yet. This happens when the status patch fails after the Release is created.
+func (a *adapter) markReleaseAsAutomated(release *v1alpha1.Release) error {
+	if release.GetLabels()[metadata.AutomatedLabel] != "true" || release.Status.Automated {
+		return nil
+	}
+
+	patch := client.MergeFrom(release.DeepCopy())
+	release.Status.Automated = true
+
+	return a.client.Status().Patch(a.ctx, release, patch)
+}
@@ -0,0 +1,331 @@
+/*
+Copyright 2023.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package snapshot
+
+import (
+	"reflect"
+	"strings"
+
+	applicationapiv1alpha1 "github.com/konflux-ci/application-api/api/v1alpha1"
+	toolkit "github.com/konflux-ci/operator-toolkit/loader"
+	"github.com/konflux-ci/release-service/api/v1alpha1"
+	"github.com/konflux-ci/release-service/loader"
+	"github.com/konflux-ci/release-service/metadata"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+var _ = Describe("Snapshot adapter", Ordered, func() {
+	var (
+		createResources func()
+		deleteResources func()
+
+		autoReleasePlan             *v1alpha1.ReleasePlan
+		disabledReleasePlan         *v1alpha1.ReleasePlan
+		otherAutoReleasePlan        *v1alpha1.ReleasePlan
+		otherApplicationReleasePlan *v1alpha1.ReleasePlan
+		snapshot                    *applicationapiv1alpha1.Snapshot
+	)
+
+	AfterAll(func() {
+		deleteResources()
+	})
+
+	BeforeAll(func() {
+		createResources()
+	})
+
+	Context("When newAdapter is called", func() {
+		It("creates and return a new adapter", func() {
+			Expect(reflect.TypeOf(newAdapter(ctx, k8sClient, nil, loader.NewLoader(), &ctrl.Log))).To(Equal(reflect.TypeOf(&adapter{})))
+		})
+	})
+
+	Context("When EnsureAutomatedReleasesAreCreated is called", func() {
+		var adapter *adapter
+
+		getReleases := func() []v1alpha1.Release {
+			releases := &v1alpha1.ReleaseList{}
+			Expect(k8sClient.List(ctx, releases, client.InNamespace("default"))).To(Succeed())
+			return releases.Items
+		}
+
+		// The Releases of the Snapshot are listed through a field index that only the manager cache provides, so
+		// they are mocked with the Releases that currently exist in the namespace.
+		mockSnapshotReleases := func() {
+			adapter.ctx = toolkit.GetMockedContext(ctx, []toolkit.MockData{
+				{
+					ContextKey: loader.SnapshotReleasesContextKey,
+					Resource:   &v1alpha1.ReleaseList{Items: getReleases()},
+				},
+			})
+		}
+
+		AfterEach(func() {
+			Expect(k8sClient.DeleteAllOf(ctx, &v1alpha1.Release{}, client.InNamespace("default"))).To(Succeed())
+		})
+
+		BeforeEach(func() {
+			adapter = newAdapter(ctx, k8sClient, snapshot.DeepCopy(), loader.NewMockLoader(), &ctrl.Log)
+			meta.SetStatusCondition(&adapter.snapshot.Status.Conditions, metav1.Condition{
+				Type:   testSucceededConditionType,
+				Status: metav1.ConditionTrue,
+				Reason: "Passed",
+			})
+		})
+
+		It("should not create any Release if the Snapshot tests have not passed", func() {
+			adapter.snapshot.Status.Conditions = nil
+
+			result, err := adapter.EnsureAutomatedReleasesAreCreated()
+			Expect(!result.RequeueRequest && !result.CancelRequest).To(BeTrue())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(getReleases()).To(BeEmpty())
+		})
+
+		It("should create an automated Release for each ReleasePlan with auto-release enabled", func() {
+			mockSnapshotReleases()
+			result, err := adapter.EnsureAutomatedReleasesAreCreated()
+			Expect(!result.RequeueRequest && !result.CancelRequest).To(BeTrue())
+			Expect(err).NotTo(HaveOccurred())
+
+			releases := getReleases()
+			Expect(releases).To(HaveLen(2))
+			for _, release := range releases {
+				Expect(release.Name).To(HavePrefix(release.Spec.ReleasePlan + "-"))
+				Expect(release.Spec.ReleasePlan).To(BeElementOf(autoReleasePlan.Name, otherAutoReleasePlan.Name))
+				Expect(release.Spec.Snapshot).To(Equal(snapshot.Name))
+				Expect(release.Labels).To(HaveKeyWithValue(metadata.AutomatedLabel, "true"))
+				Expect(release.IsAutomated()).To(BeTrue())
+			}
+		})
+
+		It("should not create a Release for a ReleasePlan that already released the Snapshot", func() {
+			existingRelease := &v1alpha1.Release{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "existing-release",
+					Namespace: "default",
+				},
+				Spec: v1alpha1.ReleaseSpec{
+					ReleasePlan: autoReleasePlan.Name,
+					Snapshot:    snapshot.Name,
+				},
+			}
+			Expect(k8sClient.Create(ctx, existingRelease)).To(Succeed())
+
+			mockSnapshotReleases()
+			result, err := adapter.EnsureAutomatedReleasesAreCreated()
+			Expect(!result.RequeueRequest && !result.CancelRequest).To(BeTrue())
+			Expect(err).NotTo(HaveOccurred())
+
+			releases := getReleases()
+			Expect(releases).To(HaveLen(2))
+			Expect(releases).To(ContainElement(HaveField("Spec.ReleasePlan", otherAutoReleasePlan.Name)))
+		})
+
+		It("should not create any Release when it's called again for the same Snapshot", func() {
+			mockSnapshotReleases()
+			_, err := adapter.EnsureAutomatedReleasesAreCreated()
+			Expect(err).NotTo(HaveOccurred())
+			mockSnapshotReleases()
+			_, err = adapter.EnsureAutomatedReleasesAreCreated()
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(getReleases()).To(HaveLen(2))
+		})
+
+		It("should not create a duplicated Release if the cache doesn't have the Release created before", func() {
+			mockSnapshotReleases()
+			_, err := adapter.EnsureAutomatedReleasesAreCreated()
+			Expect(err).NotTo(HaveOccurred())
+
+			adapter.ctx = toolkit.GetMockedContext(ctx, []toolkit.MockData{
+				{
+					ContextKey: loader.SnapshotReleasesContextKey,
+					Resource:   &v1alpha1.ReleaseList{},
+				},
+			})
+			result, err := adapter.EnsureAutomatedReleasesAreCreated()
+			Expect(!result.RequeueRequest && !result.CancelRequest).To(BeTrue())
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(getReleases()).To(HaveLen(2))
+		})
+
+		It("should mark an existing automated Release as automated if its status was not marked", func() {
+			existingRelease := &v1alpha1.Release{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      adapter.getAutomatedReleaseName(autoReleasePlan),
+					Namespace: "default",
+					Labels: map[string]string{
+						metadata.AutomatedLabel: "true",
+					},
+				},
+				Spec: v1alpha1.ReleaseSpec{
+					ReleasePlan: autoReleasePlan.Name,
+					Snapshot:    snapshot.Name,
+				},
+			}
+			Expect(k8sClient.Create(ctx, existingRelease)).To(Succeed())
+			Expect(existingRelease.IsAutomated()).To(BeFalse())
+
+			mockSnapshotReleases()
+			_, err := adapter.EnsureAutomatedReleasesAreCreated()
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(existingRelease), existingRelease)).To(Succeed())
+			Expect(existingRelease.IsAutomated()).To(BeTrue())
+		})
+
+		It("should fail if a Release with the automated name exists for another Snapshot", func() {
+			conflictingRelease := &v1alpha1.Release{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      adapter.getAutomatedReleaseName(autoReleasePlan),
+					Namespace: "default",
+				},
+				Spec: v1alpha1.ReleaseSpec{
+					ReleasePlan: autoReleasePlan.Name,
+					Snapshot:    "other-snapshot",
+				},
+			}
+			Expect(k8sClient.Create(ctx, conflictingRelease)).To(Succeed())
+
+			adapter.ctx = toolkit.GetMockedContext(ctx, []toolkit.MockData{
+				{
+					ContextKey: loader.SnapshotReleasesContextKey,
+					Resource:   &v1alpha1.ReleaseList{},
+				},
+			})
+			result, err := adapter.EnsureAutomatedReleasesAreCreated()
+			Expect(result.RequeueRequest).To(BeTrue())
+			Expect(err).To(MatchError(ContainSubstring("already exists")))
+		})
+
+		It("should not mark a Release without the automated label as automated", func() {
+			existingRelease := &v1alpha1.Release{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "manual-release",
+					Namespace: "default",
+				},
+				Spec: v1alpha1.ReleaseSpec{
+					ReleasePlan: autoReleasePlan.Name,
+					Snapshot:    snapshot.Name,
+				},
+			}
+			Expect(k8sClient.Create(ctx, existingRelease)).To(Succeed())
+
+			mockSnapshotReleases()
+			_, err := adapter.EnsureAutomatedReleasesAreCreated()
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(existingRelease), existingRelease)).To(Succeed())
+			Expect(existingRelease.IsAutomated()).To(BeFalse())
+		})
+
+		It("should not create a Release for ReleasePlans with auto-release disabled or another application", func() {
+			mockSnapshotReleases()
+			_, err := adapter.EnsureAutomatedReleasesAreCreated()
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(getReleases()).NotTo(ContainElement(HaveField("Spec.ReleasePlan",
+				BeElementOf(disabledReleasePlan.Name, otherApplicationReleasePlan.Name))))
+		})
+	})
+
+	Context("When getAutomatedReleaseName is called", func() {
+		It("returns a name derived from the ReleasePlan and the Snapshot", func() {
+			adapter := newAdapter(ctx, k8sClient, snapshot, loader.NewLoader(), &ctrl.Log)
+			Expect(adapter.getAutomatedReleaseName(autoReleasePlan)).To(MatchRegexp(`^auto-release-plan-[0-9a-f]{10}$`))
+		})
+
+		It("returns different names for ReleasePlans and Snapshots that would collide when concatenated", func() {
+			dashedReleasePlan := autoReleasePlan.DeepCopy()
+			dashedReleasePlan.Name = autoReleasePlan.Name + "-snapshot"
+			dashedSnapshot := snapshot.DeepCopy()
+			dashedSnapshot.Name = "snapshot-" + snapshot.Name
+
+			adapter := newAdapter(ctx, k8sClient, snapshot, loader.NewLoader(), &ctrl.Log)
+			dashedAdapter := newAdapter(ctx, k8sClient, dashedSnapshot, loader.NewLoader(), &ctrl.Log)
+			Expect(adapter.getAutomatedReleaseName(dashedReleasePlan)).NotTo(
+				Equal(dashedAdapter.getAutomatedReleaseName(autoReleasePlan)))
+		})
+
+		It("returns a name within the label value length if the names are too long", func() {
+			longSnapshot := snapshot.DeepCopy()
+			longSnapshot.Name = strings.Repeat("a", 63)
+			adapter := newAdapter(ctx, k8sClient, longSnapshot, loader.NewLoader(), &ctrl.Log)
+
+			name := adapter.getAutomatedReleaseName(autoReleasePlan)
+			Expect(len(name)).To(BeNumerically("<=", metadata.MaxLabelLength))
+			Expect(name).NotTo(Equal(adapter.getAutomatedReleaseName(otherAutoReleasePlan)))
+
+			longReleasePlan := autoReleasePlan.DeepCopy()
+			longReleasePlan.Name = strings.Repeat("b", 253)
+			Expect(len(adapter.getAutomatedReleaseName(longReleasePlan))).To(BeNumerically("<=", metadata.MaxLabelLength))
+		})
+	})
+
+	createResources = func() {
+		newReleasePlan := func(name, application, autoRelease string) *v1alpha1.ReleasePlan {
+			releasePlan := &v1alpha1.ReleasePlan{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      name,
+					Namespace: "default",
+					Labels: map[string]string{
+						metadata.AutoReleaseLabel: autoRelease,
+					},
+				},
+				Spec: v1alpha1.ReleasePlanSpec{
+					Application: application,
+					Target:      "default",
+				},
+			}
+			Expect(k8sClient.Create(ctx, releasePlan)).To(Succeed())
+
+			return releasePlan
+		}
+
+		autoReleasePlan = newReleasePlan("auto-release-plan", "application", "true")
+		otherAutoReleasePlan = newReleasePlan("other-auto-release-plan", "application", "true")
+		disabledReleasePlan = newReleasePlan("disabled-release-plan", "application", "false")
+		otherApplicationReleasePlan = newReleasePlan("other-application-release-plan", "other-application", "true")
+
+		snapshot = &applicationapiv1alpha1.Snapshot{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "snapshot",
+				Namespace: "default",
+			},
+			Spec: applicationapiv1alpha1.SnapshotSpec{
+				Application: "application",
+			},
+		}
+		Expect(k8sClient.Create(ctx, snapshot)).To(Succeed())
+	}
+
+	deleteResources = func() {
+		Expect(k8sClient.Delete(ctx, autoReleasePlan)).To(Succeed())
+		Expect(k8sClient.Delete(ctx, otherAutoReleasePlan)).To(Succeed())
+		Expect(k8sClient.Delete(ctx, disabledReleasePlan)).To(Succeed())
+		Expect(k8sClient.Delete(ctx, otherApplicationReleasePlan)).To(Succeed())
+		Expect(k8sClient.Delete(ctx, snapshot)).To(Succeed())
+	}
+})
@@ -0,0 +1,78 @@
+/*
+Copyright 2023.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package snapshot
+
+import (
+	"context"
+
+	"github.com/go-logr/logr"
+	applicationapiv1alpha1 "github.com/konflux-ci/application-api/api/v1alpha1"
+	"github.com/konflux-ci/operator-toolkit/controller"
+	"github.com/konflux-ci/release-service/cache"
+	"github.com/konflux-ci/release-service/loader"
+	"k8s.io/apimachinery/pkg/api/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/cluster"
+)
+
+// Controller reconciles a Snapshot object
+type Controller struct {
+	client client.Client
+	log    logr.Logger
+}
+
+//+kubebuilder:rbac:groups=appstudio.redhat.com,resources=snapshots,verbs=get;list;watch
+
+// Reconcile is part of the main kubernetes reconciliation loop which aims to
+// move the current state of the cluster closer to the desired state.
+func (c *Controller) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	logger := c.log.WithValues("Snapshot", req.NamespacedName)
+
+	snapshot := &applicationapiv1alpha1.Snapshot{}
+	err := c.client.Get(ctx, req.NamespacedName, snapshot)
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return ctrl.Result{}, nil
+		}
+
+		return ctrl.Result{}, err
+	}
+
+	adapter := newAdapter(ctx, c.client, snapshot, loader.NewLoader(), &logger)
+
+	return controller.ReconcileHandler([]controller.Operation{
+		adapter.EnsureAutomatedReleasesAreCreated,
+	})
+}
+
+// Register registers the controller with the passed manager and log. Snapshot status updates are not filtered out, as
+// that is where the result of the tests is reported.
+func (c *Controller) Register(mgr ctrl.Manager, log *logr.Logger, _ cluster.Cluster) error {
+	c.client = mgr.GetClient()
+	c.log = log.WithName("snapshot")
+
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&applicationapiv1alpha1.Snapshot{}).
+		Complete(c)
+}
+
+// SetupCache indexes fields for each of the resources used in the snapshot adapter in those cases where filtering by
+// field is required.
+func (c *Controller) SetupCache(mgr ctrl.Manager) error {
+	return cache.SetupReleaseSnapshotCache(mgr)
+}
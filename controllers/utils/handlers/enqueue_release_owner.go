@@ -0,0 +1,114 @@
+/*
+Copyright 2023.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/konflux-ci/release-service/api/v1alpha1"
+	"github.com/konflux-ci/release-service/metadata"
+	libhandler "github.com/operator-framework/operator-lib/handler"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/workqueue"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	crtHandler "sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+var _ crtHandler.EventHandler = &EnqueueRequestForReleaseOwner[client.Object]{}
+
+// releaseOwnerType is the value of the libhandler type annotation set on the objects owned by a Release.
+var releaseOwnerType = fmt.Sprintf("Release.%s", v1alpha1.GroupVersion.Group)
+
+// EnqueueRequestForReleaseOwner enqueues a Request containing the Name and Namespace of the Release owning the object
+// that is the source of the Event. The Release is read from the ReleaseNameLabel and ReleaseNamespaceLabel labels,
+// falling back to the libhandler owner annotations if the labels are not set. As the Release is found through labels
+// and annotations instead of OwnerReferences, Releases in other namespaces are supported.
+type EnqueueRequestForReleaseOwner[object client.Object] struct{}
+
+// Create implements EventHandler.
+func (e *EnqueueRequestForReleaseOwner[T]) Create(_ context.Context, createEvent event.TypedCreateEvent[T], rateLimitingInterface workqueue.TypedRateLimitingInterface[reconcile.Request]) {
+	enqueueReleaseOwner(createEvent.Object, rateLimitingInterface)
+}
+
+// Update implements EventHandler.
+func (e *EnqueueRequestForReleaseOwner[T]) Update(_ context.Context, updateEvent event.TypedUpdateEvent[T], rateLimitingInterface workqueue.TypedRateLimitingInterface[reconcile.Request]) {
+	enqueueReleaseOwner(updateEvent.ObjectOld, rateLimitingInterface)
+	enqueueReleaseOwner(updateEvent.ObjectNew, rateLimitingInterface)
+}
+
+// Delete implements EventHandler. Deletes observed through a tombstone are handled the same way, so pruned objects
+// still trigger the reconciliation of their Release.
+func (e *EnqueueRequestForReleaseOwner[T]) Delete(_ context.Context, deleteEvent event.TypedDeleteEvent[T], rateLimitingInterface workqueue.TypedRateLimitingInterface[reconcile.Request]) {
+	enqueueReleaseOwner(deleteEvent.Object, rateLimitingInterface)
+}
+
+// Generic implements EventHandler.
+func (e *EnqueueRequestForReleaseOwner[T]) Generic(_ context.Context, genericEvent event.TypedGenericEvent[T], rateLimitingInterface workqueue.TypedRateLimitingInterface[reconcile.Request]) {
+	enqueueReleaseOwner(genericEvent.Object, rateLimitingInterface)
+}
+
+// enqueueReleaseOwner adds a request to the RateLimitingInterface for the Release owning the given object, if any.
+func enqueueReleaseOwner(object client.Object, rateLimitingInterface workqueue.TypedRateLimitingInterface[reconcile.Request]) {
+	if namespacedName, found := getReleaseOwner(object); found {
+		rateLimitingInterface.Add(reconcile.Request{NamespacedName: namespacedName})
+	}
+}
+
+// getReleaseOwner returns the name and namespace of the Release owning the given object and a boolean indicating
+// whether it was found. Label values are sanitized, so long names might be truncated in them. For that reason, the
+// owner annotations are preferred when they refer to the same Release as the labels.
+func getReleaseOwner(object client.Object) (types.NamespacedName, bool) {
+	if object == nil {
+		return types.NamespacedName{}, false
+	}
+
+	owner, hasOwner := getReleaseOwnerFromAnnotations(object)
+
+	labels := object.GetLabels()
+	name, hasName := labels[metadata.ReleaseNameLabel]
+	namespace, hasNamespace := labels[metadata.ReleaseNamespaceLabel]
+	if !hasName || !hasNamespace || name == "" || namespace == "" {
+		return owner, hasOwner
+	}
+
+	if hasOwner && metadata.SanitizeLabelValue(owner.Name) == name &&
+		metadata.SanitizeLabelValue(owner.Namespace) == namespace {
+		return owner, true
+	}
+
+	return types.NamespacedName{Namespace: namespace, Name: name}, true
+}
+
+// getReleaseOwnerFromAnnotations returns the name and namespace of the Release set in the libhandler owner annotations
+// of the given object and a boolean indicating whether the annotations refer to a Release.
+func getReleaseOwnerFromAnnotations(object client.Object) (types.NamespacedName, bool) {
+	annotations := object.GetAnnotations()
+	if annotations[libhandler.TypeAnnotation] != releaseOwnerType {
+		return types.NamespacedName{}, false
+	}
+
+	values := strings.SplitN(annotations[libhandler.NamespacedNameAnnotation], "/", 2)
+	if len(values) < 2 || values[0] == "" || values[1] == "" {
+		return types.NamespacedName{}, false
+	}
+
+	return types.NamespacedName{Namespace: values[0], Name: values[1]}, true
+}
@@ -0,0 +1,167 @@
+/*
+Copyright 2023.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package handlers
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/konflux-ci/release-service/metadata"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	libhandler "github.com/operator-framework/operator-lib/handler"
+	tektonv1 "github.com/tektoncd/pipeline/pkg/apis/pipeline/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/workqueue"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+var _ = Describe("EnqueueRequestForReleaseOwner", func() {
+	var ctx = context.TODO()
+
+	var rateLimitingInterface workqueue.TypedRateLimitingInterface[reconcile.Request]
+	var instance EnqueueRequestForReleaseOwner[client.Object]
+	var pipelineRun *tektonv1.PipelineRun
+	var expectedRequest reconcile.Request
+
+	BeforeEach(func() {
+		limiter := workqueue.NewTypedItemExponentialFailureRateLimiter[reconcile.Request](1*time.Millisecond, 1*time.Second)
+		rateLimitingInterface = workqueue.NewTypedRateLimitingQueue[reconcile.Request](limiter)
+		pipelineRun = &tektonv1.PipelineRun{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "managed",
+				Name:      "pipeline-run",
+				Labels: map[string]string{
+					metadata.ReleaseNameLabel:      "release",
+					metadata.ReleaseNamespaceLabel: "tenant",
+				},
+			},
+		}
+		expectedRequest = reconcile.Request{
+			NamespacedName: types.NamespacedName{
+				Namespace: "tenant",
+				Name:      "release",
+			},
+		}
+	})
+
+	When("A CreateEvent occurs", func() {
+		It("should enqueue a request for the Release in the namespace set in the labels", func() {
+			instance.Create(ctx, event.CreateEvent{Object: pipelineRun}, rateLimitingInterface)
+			Expect(rateLimitingInterface.Len()).To(Equal(1))
+
+			i, _ := rateLimitingInterface.Get()
+			Expect(i).To(Equal(expectedRequest))
+		})
+
+		It("should fall back to the owner annotations if the labels are missing", func() {
+			pipelineRun.Labels = nil
+			pipelineRun.Annotations = map[string]string{
+				libhandler.NamespacedNameAnnotation: "tenant/release",
+				libhandler.TypeAnnotation:           "Release.appstudio.redhat.com",
+			}
+
+			instance.Create(ctx, event.CreateEvent{Object: pipelineRun}, rateLimitingInterface)
+			Expect(rateLimitingInterface.Len()).To(Equal(1))
+
+			i, _ := rateLimitingInterface.Get()
+			Expect(i).To(Equal(expectedRequest))
+		})
+
+		It("should prefer the owner annotations if the labels contain a truncated name", func() {
+			name := strings.Repeat("a", 100)
+			pipelineRun.Labels[metadata.ReleaseNameLabel] = metadata.SanitizeLabelValue(name)
+			pipelineRun.Annotations = map[string]string{
+				libhandler.NamespacedNameAnnotation: "tenant/" + name,
+				libhandler.TypeAnnotation:           "Release.appstudio.redhat.com",
+			}
+
+			instance.Create(ctx, event.CreateEvent{Object: pipelineRun}, rateLimitingInterface)
+			Expect(rateLimitingInterface.Len()).To(Equal(1))
+
+			i, _ := rateLimitingInterface.Get()
+			Expect(i.NamespacedName.Name).To(Equal(name))
+		})
+
+		It("should not enqueue a request if the labels and annotations are missing", func() {
+			pipelineRun.Labels = nil
+
+			instance.Create(ctx, event.CreateEvent{Object: pipelineRun}, rateLimitingInterface)
+			Expect(rateLimitingInterface.Len()).To(Equal(0))
+		})
+
+		It("should not enqueue a request if the owner annotations refer to another kind", func() {
+			pipelineRun.Labels = nil
+			pipelineRun.Annotations = map[string]string{
+				libhandler.NamespacedNameAnnotation: "tenant/release-plan",
+				libhandler.TypeAnnotation:           "ReleasePlan.appstudio.redhat.com",
+			}
+
+			instance.Create(ctx, event.CreateEvent{Object: pipelineRun}, rateLimitingInterface)
+			Expect(rateLimitingInterface.Len()).To(Equal(0))
+		})
+	})
+
+	When("A UpdateEvent occurs", func() {
+		It("should enqueue a single request if both objects are owned by the same Release", func() {
+			updateEvent := event.UpdateEvent{
+				ObjectOld: pipelineRun,
+				ObjectNew: pipelineRun.DeepCopy(),
+			}
+
+			instance.Update(ctx, updateEvent, rateLimitingInterface)
+			Expect(rateLimitingInterface.Len()).To(Equal(1))
+		})
+	})
+
+	When("A DeleteEvent occurs", func() {
+		It("should enqueue a request for the Release", func() {
+			instance.Delete(ctx, event.DeleteEvent{Object: pipelineRun}, rateLimitingInterface)
+			Expect(rateLimitingInterface.Len()).To(Equal(1))
+
+			i, _ := rateLimitingInterface.Get()
+			Expect(i).To(Equal(expectedRequest))
+		})
+
+		It("should enqueue a request for the Release if the final state of the object is unknown", func() {
+			deleteEvent := event.DeleteEvent{
+				Object:             pipelineRun,
+				DeleteStateUnknown: true,
+			}
+
+			instance.Delete(ctx, deleteEvent, rateLimitingInterface)
+			Expect(rateLimitingInterface.Len()).To(Equal(1))
+
+			i, _ := rateLimitingInterface.Get()
+			Expect(i).To(Equal(expectedRequest))
+		})
+	})
+
+	When("A GenericEvent occurs", func() {
+		It("should enqueue a request for the Release", func() {
+			instance.Generic(ctx, event.GenericEvent{Object: pipelineRun}, rateLimitingInterface)
+			Expect(rateLimitingInterface.Len()).To(Equal(1))
+
+			i, _ := rateLimitingInterface.Get()
+			Expect(i).To(Equal(expectedRequest))
+		})
+	})
+})
@@ -0,0 +1,77 @@
+/*
+Copyright 2023.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package handlers
+
+import (
+	"context"
+
+	"github.com/konflux-ci/release-service/api/v1alpha1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/workqueue"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	crtHandler "sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+var _ crtHandler.EventHandler = &EnqueueRequestForWaitingReleases[client.Object]{}
+
+// EnqueueRequestForWaitingReleases enqueues a Request containing the Name and Namespace of each Release waiting for its
+// ReleasePlanAdmission to be created in the origin namespace of the ReleasePlanAdmission that is the source of the
+// Event. Only CreateEvents are handled, as the Releases waiting for a ReleasePlanAdmission are only unblocked by its
+// creation.
+type EnqueueRequestForWaitingReleases[object client.Object] struct {
+	// Reader is used to list the Releases in the origin namespace of the ReleasePlanAdmission
+	Reader client.Reader
+}
+
+// Create implements EventHandler.
+func (e *EnqueueRequestForWaitingReleases[T]) Create(ctx context.Context, createEvent event.TypedCreateEvent[T], rateLimitingInterface workqueue.TypedRateLimitingInterface[reconcile.Request]) {
+	releasePlanAdmission, ok := any(createEvent.Object).(*v1alpha1.ReleasePlanAdmission)
+	if !ok || releasePlanAdmission.Spec.Origin == "" {
+		return
+	}
+
+	releases := &v1alpha1.ReleaseList{}
+	err := e.Reader.List(ctx, releases, client.InNamespace(releasePlanAdmission.Spec.Origin))
+	if err != nil {
+		log.FromContext(ctx).Error(err, "failed to list the Releases waiting for a ReleasePlanAdmission",
+			"ReleasePlanAdmission.Name", releasePlanAdmission.Name, "ReleasePlanAdmission.Namespace", releasePlanAdmission.Namespace)
+		return
+	}
+
+	for _, release := range releases.Items {
+		if release.IsReleasePlanAdmissionMissing() {
+			rateLimitingInterface.Add(reconcile.Request{
+				NamespacedName: types.NamespacedName{Namespace: release.Namespace, Name: release.Name},
+			})
+		}
+	}
+}
+
+// Update implements EventHandler.
+func (e *EnqueueRequestForWaitingReleases[T]) Update(_ context.Context, _ event.TypedUpdateEvent[T], _ workqueue.TypedRateLimitingInterface[reconcile.Request]) {
+}
+
+// Delete implements EventHandler.
+func (e *EnqueueRequestForWaitingReleases[T]) Delete(_ context.Context, _ event.TypedDeleteEvent[T], _ workqueue.TypedRateLimitingInterface[reconcile.Request]) {
+}
+
+// Generic implements EventHandler.
+func (e *EnqueueRequestForWaitingReleases[T]) Generic(_ context.Context, _ event.TypedGenericEvent[T], _ workqueue.TypedRateLimitingInterface[reconcile.Request]) {
+}
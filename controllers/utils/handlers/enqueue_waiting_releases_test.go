@@ -0,0 +1,122 @@
+/*
+Copyright 2023.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package handlers
+
+import (
+	"context"
+	"time"
+
+	"github.com/konflux-ci/release-service/api/v1alpha1"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	tektonv1 "github.com/tektoncd/pipeline/pkg/apis/pipeline/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/util/workqueue"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+var _ = Describe("EnqueueRequestForWaitingReleases", func() {
+	var ctx = context.TODO()
+
+	var rateLimitingInterface workqueue.TypedRateLimitingInterface[reconcile.Request]
+	var instance EnqueueRequestForWaitingReleases[client.Object]
+	var releasePlanAdmission *v1alpha1.ReleasePlanAdmission
+
+	newRelease := func(name, namespace string) *v1alpha1.Release {
+		release := &v1alpha1.Release{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: namespace,
+			},
+		}
+		release.MarkReleasing("")
+		return release
+	}
+
+	BeforeEach(func() {
+		limiter := workqueue.NewTypedItemExponentialFailureRateLimiter[reconcile.Request](1*time.Millisecond, 1*time.Second)
+		rateLimitingInterface = workqueue.NewTypedRateLimitingQueue[reconcile.Request](limiter)
+
+		waitingRelease := newRelease("waiting-release", "tenant")
+		waitingRelease.MarkReleasePlanAdmissionMissing("")
+		validatedRelease := newRelease("validated-release", "tenant")
+		validatedRelease.MarkValidated()
+		otherNamespaceRelease := newRelease("other-namespace-release", "other")
+		otherNamespaceRelease.MarkReleasePlanAdmissionMissing("")
+
+		instance = EnqueueRequestForWaitingReleases[client.Object]{
+			Reader: fake.NewClientBuilder().
+				WithScheme(scheme.Scheme).
+				WithObjects(waitingRelease, validatedRelease, otherNamespaceRelease).
+				Build(),
+		}
+
+		releasePlanAdmission = &v1alpha1.ReleasePlanAdmission{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "release-plan-admission",
+				Namespace: "managed",
+			},
+			Spec: v1alpha1.ReleasePlanAdmissionSpec{
+				Origin: "tenant",
+			},
+		}
+	})
+
+	When("A CreateEvent occurs", func() {
+		It("should only enqueue the Releases waiting for a ReleasePlanAdmission in the origin namespace", func() {
+			instance.Create(ctx, event.CreateEvent{Object: releasePlanAdmission}, rateLimitingInterface)
+			Expect(rateLimitingInterface.Len()).To(Equal(1))
+
+			i, _ := rateLimitingInterface.Get()
+			Expect(i).To(Equal(reconcile.Request{
+				NamespacedName: types.NamespacedName{Namespace: "tenant", Name: "waiting-release"},
+			}))
+		})
+
+		It("should not enqueue any request if the object is not a ReleasePlanAdmission", func() {
+			instance.Create(ctx, event.CreateEvent{Object: &tektonv1.PipelineRun{}}, rateLimitingInterface)
+			Expect(rateLimitingInterface.Len()).To(Equal(0))
+		})
+	})
+
+	When("An UpdateEvent occurs", func() {
+		It("should not enqueue any request", func() {
+			instance.Update(ctx, event.UpdateEvent{ObjectOld: releasePlanAdmission, ObjectNew: releasePlanAdmission},
+				rateLimitingInterface)
+			Expect(rateLimitingInterface.Len()).To(Equal(0))
+		})
+	})
+
+	When("A DeleteEvent occurs", func() {
+		It("should not enqueue any request", func() {
+			instance.Delete(ctx, event.DeleteEvent{Object: releasePlanAdmission}, rateLimitingInterface)
+			Expect(rateLimitingInterface.Len()).To(Equal(0))
+		})
+	})
+
+	When("A GenericEvent occurs", func() {
+		It("should not enqueue any request", func() {
+			instance.Generic(ctx, event.GenericEvent{Object: releasePlanAdmission}, rateLimitingInterface)
+			Expect(rateLimitingInterface.Len()).To(Equal(0))
+		})
+	})
+})
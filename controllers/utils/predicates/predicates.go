@@ -51,6 +51,26 @@ func MatchPredicate() predicate.Predicate {
 	}
 }
 
+// RetryPredicate returns a predicate which returns true when the retry annotation is added to an object. Releases are
+// only considered when they can be retried, so the annotation is ignored for Releases that succeeded or that are still
+// being processed.
+func RetryPredicate() predicate.Predicate {
+	return predicate.Funcs{
+		CreateFunc: func(createEvent event.CreateEvent) bool {
+			return false
+		},
+		DeleteFunc: func(deleteEvent event.DeleteEvent) bool {
+			return false
+		},
+		GenericFunc: func(genericEvent event.GenericEvent) bool {
+			return false
+		},
+		UpdateFunc: func(e event.UpdateEvent) bool {
+			return hasRetryAnnotationBeenAdded(e.ObjectOld, e.ObjectNew) && isRetriable(e.ObjectNew)
+		},
+	}
+}
+
 // hasConditionChanged returns true if one, but not both, of the conditions
 // are nil or if both are not nil and have different lastTransitionTimes.
 func hasConditionChanged(conditionOld, conditionNew *metav1.Condition) bool {
@@ -73,6 +93,13 @@ func hasBehaviorLabelChanged(objectOld, objectNew client.Object) bool {
 	return false
 }
 
+// hasRetryAnnotationBeenAdded returns true if the retry annotation is set in the new object but not in the old one.
+func hasRetryAnnotationBeenAdded(objectOld, objectNew client.Object) bool {
+	_, foundOld := objectOld.GetAnnotations()[metadata.RetryAnnotation]
+	_, foundNew := objectNew.GetAnnotations()[metadata.RetryAnnotation]
+	return !foundOld && foundNew
+}
+
 // haveApplicationsChanged returns true if passed objects are of the same kind and the
 // Spec.Application(s) values between them is different.
 func haveApplicationsChanged(objectOld, objectNew client.Object) bool {
@@ -136,3 +163,12 @@ func hasSourceChanged(objectOld, objectNew client.Object) bool {
 
 	return false
 }
+
+// isRetriable returns true if the object is not a Release or if it's a Release that can be retried.
+func isRetriable(object client.Object) bool {
+	if release, ok := object.(*v1alpha1.Release); ok {
+		return release.IsRetriable()
+	}
+
+	return true
+}
@@ -388,6 +388,132 @@ var _ = Describe("Predicates", Ordered, func() {
 		})
 	})
 
+	Context("Working with Releases", func() {
+		var release, releaseWithRetry *v1alpha1.Release
+		var instance predicate.Predicate
+
+		BeforeAll(func() {
+			release = &v1alpha1.Release{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "release",
+					Namespace: namespace,
+				},
+			}
+			release.MarkReleasing("")
+			release.MarkManagedPipelineProcessing()
+			release.MarkManagedPipelineProcessingFailed("")
+			release.MarkReleaseFailed("")
+			releaseWithRetry = release.DeepCopy()
+			releaseWithRetry.Annotations = map[string]string{
+				metadata.RetryAnnotation: "true",
+			}
+
+			instance = RetryPredicate()
+		})
+
+		When("calling RetryPredicate", func() {
+			It("should ignore creating events", func() {
+				contextEvent := event.CreateEvent{
+					Object: releaseWithRetry,
+				}
+				Expect(instance.Create(contextEvent)).To(BeFalse())
+			})
+
+			It("should ignore deleting events", func() {
+				contextEvent := event.DeleteEvent{
+					Object: releaseWithRetry,
+				}
+				Expect(instance.Delete(contextEvent)).To(BeFalse())
+			})
+
+			It("should ignore generic events", func() {
+				contextEvent := event.GenericEvent{
+					Object: releaseWithRetry,
+				}
+				Expect(instance.Generic(contextEvent)).To(BeFalse())
+			})
+
+			It("returns true when the retry annotation is added", func() {
+				contextEvent := event.UpdateEvent{
+					ObjectOld: release,
+					ObjectNew: releaseWithRetry,
+				}
+				Expect(instance.Update(contextEvent)).To(BeTrue())
+			})
+
+			It("returns false when the retry annotation is removed", func() {
+				contextEvent := event.UpdateEvent{
+					ObjectOld: releaseWithRetry,
+					ObjectNew: release,
+				}
+				Expect(instance.Update(contextEvent)).To(BeFalse())
+			})
+
+			It("returns false when the retry annotation was already set", func() {
+				contextEvent := event.UpdateEvent{
+					ObjectOld: releaseWithRetry,
+					ObjectNew: releaseWithRetry,
+				}
+				Expect(instance.Update(contextEvent)).To(BeFalse())
+			})
+
+			It("returns false when the retry annotation is added to a succeeded Release", func() {
+				succeededRelease := release.DeepCopy()
+				succeededRelease.Status.Conditions = nil
+				succeededRelease.MarkReleasing("")
+				succeededRelease.MarkManagedPipelineProcessing()
+				succeededRelease.MarkManagedPipelineProcessed()
+				succeededRelease.MarkReleased()
+				succeededReleaseWithRetry := succeededRelease.DeepCopy()
+				succeededReleaseWithRetry.Annotations = map[string]string{
+					metadata.RetryAnnotation: "true",
+				}
+
+				contextEvent := event.UpdateEvent{
+					ObjectOld: succeededRelease,
+					ObjectNew: succeededReleaseWithRetry,
+				}
+				Expect(instance.Update(contextEvent)).To(BeFalse())
+			})
+
+			It("returns false when the retry annotation is added to a Release whose managed pipeline is still running", func() {
+				runningRelease := release.DeepCopy()
+				runningRelease.Status.Conditions = nil
+				runningRelease.MarkReleasing("")
+				runningRelease.MarkManagedPipelineProcessing()
+				runningReleaseWithRetry := runningRelease.DeepCopy()
+				runningReleaseWithRetry.Annotations = map[string]string{
+					metadata.RetryAnnotation: "true",
+				}
+
+				contextEvent := event.UpdateEvent{
+					ObjectOld: runningRelease,
+					ObjectNew: runningReleaseWithRetry,
+				}
+				Expect(instance.Update(contextEvent)).To(BeFalse())
+			})
+
+			It("returns true when the retry annotation is added to a Release that timed out", func() {
+				timedOutRelease := release.DeepCopy()
+				timedOutRelease.Status.Conditions = nil
+				timedOutRelease.MarkReleasing("")
+				timedOutRelease.MarkManagedPipelineProcessing()
+				timedOutRelease.MarkManagedPipelineProcessingFailed("")
+				timedOutRelease.MarkReleaseFailedWithReason(v1alpha1.TimeoutReason, "")
+				timedOutReleaseWithRetry := timedOutRelease.DeepCopy()
+				timedOutReleaseWithRetry.Annotations = map[string]string{
+					metadata.RetryAnnotation: "true",
+				}
+
+				contextEvent := event.UpdateEvent{
+					ObjectOld: timedOutRelease,
+					ObjectNew: timedOutReleaseWithRetry,
+				}
+				Expect(instance.Update(contextEvent)).To(BeTrue())
+			})
+		})
+	})
+
 	When("calling hasConditionChanged", func() {
 		It("returns false when both conditions are nil", func() {
 			Expect(hasConditionChanged(nil, nil)).To(BeFalse())
@@ -474,4 +600,32 @@ var _ = Describe("Predicates", Ordered, func() {
 			Expect(hasBehaviorLabelChanged(podMissing, podMissing)).To(BeFalse())
 		})
 	})
+
+	When("calling isRetriable", func() {
+		It("returns true when the object is not a Release", func() {
+			Expect(isRetriable(&corev1.Pod{})).To(BeTrue())
+		})
+
+		It("returns true when the Release failed on the managed pipeline", func() {
+			release := &v1alpha1.Release{}
+			release.MarkReleasing("")
+			release.MarkManagedPipelineProcessing()
+			release.MarkManagedPipelineProcessingFailed("")
+			release.MarkReleaseFailed("")
+			Expect(isRetriable(release)).To(BeTrue())
+		})
+
+		It("returns false when the Release has not failed", func() {
+			release := &v1alpha1.Release{}
+			release.MarkReleasing("")
+			Expect(isRetriable(release)).To(BeFalse())
+		})
+
+		It("returns false when the Release failed before running the managed pipeline", func() {
+			release := &v1alpha1.Release{}
+			release.MarkReleasing("")
+			release.MarkReleaseFailed("")
+			Expect(isRetriable(release)).To(BeFalse())
+		})
+	})
 })
@@ -27,21 +27,34 @@ import (
 // ErrInvalidRoleBindingRef is returned when PipelineInfo.RoleBindings does no parse as “namespace/name”.
 var ErrInvalidRoleBindingRef = fmt.Errorf("pipelineInfo doesn't contain a valid reference to a RoleBinding")
 
+// ErrReleasePlanAdmissionNotFound is returned when no ReleasePlanAdmission matches a ReleasePlan.
+var ErrReleasePlanAdmissionNotFound = fmt.Errorf("no ReleasePlanAdmission found")
+
+// ErrReleasePlanHasNoTarget is returned when a ReleasePlan has no target, so no ReleasePlanAdmission can match it.
+var ErrReleasePlanHasNoTarget = fmt.Errorf("%w: releasePlan has no target", ErrReleasePlanAdmissionNotFound)
+
 type ObjectLoader interface {
 	GetActiveReleasePlanAdmission(ctx context.Context, cli client.Client, releasePlan *v1alpha1.ReleasePlan) (*v1alpha1.ReleasePlanAdmission, error)
 	GetActiveReleasePlanAdmissionFromRelease(ctx context.Context, cli client.Client, release *v1alpha1.Release) (*v1alpha1.ReleasePlanAdmission, error)
 	GetApplication(ctx context.Context, cli client.Client, releasePlan *v1alpha1.ReleasePlan) (*applicationapiv1alpha1.Application, error)
+	GetAutoReleasePlans(ctx context.Context, cli client.Client, snapshot *applicationapiv1alpha1.Snapshot) (*v1alpha1.ReleasePlanList, error)
 	GetEnterpriseContractConfigMap(ctx context.Context, cli client.Client) (*corev1.ConfigMap, error)
 	GetEnterpriseContractPolicy(ctx context.Context, cli client.Client, releasePlanAdmission *v1alpha1.ReleasePlanAdmission) (*ecapiv1alpha1.EnterpriseContractPolicy, error)
 	GetMatchingReleasePlanAdmission(ctx context.Context, cli client.Client, releasePlan *v1alpha1.ReleasePlan) (*v1alpha1.ReleasePlanAdmission, error)
 	GetMatchingReleasePlans(ctx context.Context, cli client.Client, releasePlanAdmission *v1alpha1.ReleasePlanAdmission) (*v1alpha1.ReleasePlanList, error)
 	GetPreviousRelease(ctx context.Context, cli client.Client, release *v1alpha1.Release) (*v1alpha1.Release, error)
+	GetQueuedReleases(ctx context.Context, cli client.Client, releasePlanAdmission *v1alpha1.ReleasePlanAdmission) (*v1alpha1.ReleaseList, error)
 	GetRelease(ctx context.Context, cli client.Client, name, namespace string) (*v1alpha1.Release, error)
 	GetRoleBindingFromReleaseStatusPipelineInfo(ctx context.Context, cli client.Client, pipelineInfo *v1alpha1.PipelineInfo, roleBindingType string) (*rbac.RoleBinding, error)
 	GetReleasePipelineRun(ctx context.Context, cli client.Client, release *v1alpha1.Release, pipelineType metadata.PipelineType) (*tektonv1.PipelineRun, error)
+	GetReleasePipelineRuns(ctx context.Context, cli client.Client, release *v1alpha1.Release) (*tektonv1.PipelineRunList, error)
 	GetReleasePlan(ctx context.Context, cli client.Client, release *v1alpha1.Release) (*v1alpha1.ReleasePlan, error)
+	GetReleasePlanAdmissionPipelineRuns(ctx context.Context, cli client.Client, releasePlanAdmission *v1alpha1.ReleasePlanAdmission) (*tektonv1.PipelineRunList, error)
 	GetReleaseServiceConfig(ctx context.Context, cli client.Client, name, namespace string) (*v1alpha1.ReleaseServiceConfig, error)
+	GetSecret(ctx context.Context, cli client.Client, name, namespace string) (*corev1.Secret, error)
+	GetServiceAccount(ctx context.Context, cli client.Client, name, namespace string) (*corev1.ServiceAccount, error)
 	GetSnapshot(ctx context.Context, cli client.Client, release *v1alpha1.Release) (*applicationapiv1alpha1.Snapshot, error)
+	GetSnapshotReleases(ctx context.Context, cli client.Client, snapshot *applicationapiv1alpha1.Snapshot) (*v1alpha1.ReleaseList, error)
 	GetProcessingResources(ctx context.Context, cli client.Client, release *v1alpha1.Release) (*ProcessingResources, error)
 }
 
@@ -90,6 +103,27 @@ func (l *loader) GetApplication(ctx context.Context, cli client.Client, releaseP
 	return application, toolkit.GetObject(releasePlan.Spec.Application, releasePlan.Namespace, cli, ctx, application)
 }
 
+// GetAutoReleasePlans returns a list of all the ReleasePlans in the namespace of the given Snapshot that have the
+// auto-release label set to true and reference the same application. If the List operation fails, an error will be
+// returned.
+func (l *loader) GetAutoReleasePlans(ctx context.Context, cli client.Client, snapshot *applicationapiv1alpha1.Snapshot) (*v1alpha1.ReleasePlanList, error) {
+	releasePlans := &v1alpha1.ReleasePlanList{}
+	err := cli.List(ctx, releasePlans,
+		client.InNamespace(snapshot.Namespace),
+		client.MatchingLabels{metadata.AutoReleaseLabel: "true"})
+	if err != nil {
+		return nil, err
+	}
+
+	for i := len(releasePlans.Items) - 1; i >= 0; i-- {
+		if releasePlans.Items[i].Spec.Application != snapshot.Spec.Application {
+			releasePlans.Items = append(releasePlans.Items[:i], releasePlans.Items[i+1:]...)
+		}
+	}
+
+	return releasePlans, nil
+}
+
 // GetEnterpriseContractPolicy returns the EnterpriseContractPolicy referenced by the given ReleasePlanAdmission. If the
 // EnterpriseContractPolicy is not found or the Get operation fails, an error is returned.
 func (l *loader) GetEnterpriseContractPolicy(ctx context.Context, cli client.Client, releasePlanAdmission *v1alpha1.ReleasePlanAdmission) (*ecapiv1alpha1.EnterpriseContractPolicy, error) {
@@ -133,7 +167,7 @@ func (l *loader) GetMatchingReleasePlanAdmission(ctx context.Context, cli client
 	}
 
 	if releasePlan.Spec.Target == "" {
-		return nil, fmt.Errorf("releasePlan has no target, so no ReleasePlanAdmissions can be found")
+		return nil, ErrReleasePlanHasNoTarget
 	}
 
 	releasePlanAdmissions := &v1alpha1.ReleasePlanAdmissionList{}
@@ -160,8 +194,8 @@ func (l *loader) GetMatchingReleasePlanAdmission(ctx context.Context, cli client
 	}
 
 	if foundReleasePlanAdmission == nil {
-		return nil, fmt.Errorf("no ReleasePlanAdmission found in namespace (%+s) with the origin (%+s) for application '%s'",
-			releasePlan.Spec.Target, releasePlan.Namespace, releasePlan.Spec.Application)
+		return nil, fmt.Errorf("%w in namespace (%+s) with the origin (%+s) for application '%s'",
+			ErrReleasePlanAdmissionNotFound, releasePlan.Spec.Target, releasePlan.Namespace, releasePlan.Spec.Application)
 	}
 
 	return foundReleasePlanAdmission, nil
@@ -249,6 +283,17 @@ func (l *loader) GetPreviousRelease(ctx context.Context, cli client.Client, rele
 	return previousRelease, nil
 }
 
+// GetQueuedReleases returns a list of all the Releases waiting for a free slot in the PipelineRun concurrency limit of
+// the given ReleasePlanAdmission, regardless of their namespace. If the List operation fails, an error will be returned.
+func (l *loader) GetQueuedReleases(ctx context.Context, cli client.Client, releasePlanAdmission *v1alpha1.ReleasePlanAdmission) (*v1alpha1.ReleaseList, error) {
+	releases := &v1alpha1.ReleaseList{}
+	err := cli.List(ctx, releases,
+		client.MatchingFields{"status.queue": fmt.Sprintf("%s%c%s",
+			releasePlanAdmission.Namespace, types.Separator, releasePlanAdmission.Name)})
+
+	return releases, err
+}
+
 // GetRelease returns the Release with the given name and namespace. If the Release is not found or the Get operation
 // fails, an error will be returned.
 func (l *loader) GetRelease(ctx context.Context, cli client.Client, name, namespace string) (*v1alpha1.Release, error) {
@@ -289,7 +334,8 @@ func (l *loader) GetRoleBindingFromReleaseStatusPipelineInfo(ctx context.Context
 }
 
 // GetReleasePipelineRun returns the Release PipelineRun of the specified type referenced by the given Release
-// or nil if it's not found. In the case the List operation fails, an error will be returned.
+// or nil if it's not found. If more than one PipelineRun matches, the most recently created one is returned.
+// In the case the List operation fails, an error will be returned.
 func (l *loader) GetReleasePipelineRun(ctx context.Context, cli client.Client, release *v1alpha1.Release, pipelineType metadata.PipelineType) (*tektonv1.PipelineRun, error) {
 	if pipelineType != metadata.ManagedCollectorsPipelineType && pipelineType != metadata.ManagedPipelineType &&
 		pipelineType != metadata.TenantCollectorsPipelineType && pipelineType != metadata.TenantPipelineType && pipelineType != metadata.FinalPipelineType {
@@ -298,17 +344,37 @@ func (l *loader) GetReleasePipelineRun(ctx context.Context, cli client.Client, r
 
 	pipelineRuns := &tektonv1.PipelineRunList{}
 	err := cli.List(ctx, pipelineRuns,
-		client.Limit(1),
 		client.MatchingLabels{
-			metadata.ReleaseNameLabel:      release.Name,
-			metadata.ReleaseNamespaceLabel: release.Namespace,
+			metadata.ReleaseNameLabel:      metadata.SanitizeLabelValue(release.Name),
+			metadata.ReleaseNamespaceLabel: metadata.SanitizeLabelValue(release.Namespace),
 			metadata.PipelinesTypeLabel:    pipelineType.String(),
 		})
-	if err == nil && len(pipelineRuns.Items) > 0 {
-		return &pipelineRuns.Items[0], nil
+	if err != nil || len(pipelineRuns.Items) == 0 {
+		return nil, err
+	}
+
+	latest := &pipelineRuns.Items[0]
+	for i := range pipelineRuns.Items[1:] {
+		pipelineRun := &pipelineRuns.Items[i+1]
+		if latest.CreationTimestamp.Before(&pipelineRun.CreationTimestamp) {
+			latest = pipelineRun
+		}
 	}
 
-	return nil, err
+	return latest, nil
+}
+
+// GetReleasePipelineRuns returns a list of all the Release PipelineRuns associated with the given Release, regardless
+// of their type or namespace. If the List operation fails, an error will be returned.
+func (l *loader) GetReleasePipelineRuns(ctx context.Context, cli client.Client, release *v1alpha1.Release) (*tektonv1.PipelineRunList, error) {
+	pipelineRuns := &tektonv1.PipelineRunList{}
+	err := cli.List(ctx, pipelineRuns,
+		client.MatchingLabels{
+			metadata.ReleaseNameLabel:      metadata.SanitizeLabelValue(release.Name),
+			metadata.ReleaseNamespaceLabel: metadata.SanitizeLabelValue(release.Namespace),
+		})
+
+	return pipelineRuns, err
 }
 
 // GetReleasePlan returns the ReleasePlan referenced by the given Release. If the ReleasePlan is not found or
@@ -318,6 +384,20 @@ func (l *loader) GetReleasePlan(ctx context.Context, cli client.Client, release
 	return releasePlan, toolkit.GetObject(release.Spec.ReleasePlan, release.Namespace, cli, ctx, releasePlan)
 }
 
+// GetReleasePlanAdmissionPipelineRuns returns a list of all the managed PipelineRuns created for the given
+// ReleasePlanAdmission. If the List operation fails, an error will be returned.
+func (l *loader) GetReleasePlanAdmissionPipelineRuns(ctx context.Context, cli client.Client, releasePlanAdmission *v1alpha1.ReleasePlanAdmission) (*tektonv1.PipelineRunList, error) {
+	pipelineRuns := &tektonv1.PipelineRunList{}
+	err := cli.List(ctx, pipelineRuns,
+		client.InNamespace(releasePlanAdmission.Namespace),
+		client.MatchingLabels{
+			metadata.PipelinesTypeLabel:        metadata.ManagedPipelineType.String(),
+			metadata.ReleasePlanAdmissionLabel: metadata.SanitizeLabelValue(releasePlanAdmission.Name),
+		})
+
+	return pipelineRuns, err
+}
+
 // GetReleaseServiceConfig returns the ReleaseServiceConfig with the given name and namespace. If the ReleaseServiceConfig is not
 // found or the Get operation fails, an error will be returned.
 func (l *loader) GetReleaseServiceConfig(ctx context.Context, cli client.Client, name, namespace string) (*v1alpha1.ReleaseServiceConfig, error) {
@@ -325,6 +405,20 @@ func (l *loader) GetReleaseServiceConfig(ctx context.Context, cli client.Client,
 	return releaseServiceConfig, toolkit.GetObject(name, namespace, cli, ctx, releaseServiceConfig)
 }
 
+// GetSecret returns the Secret with the given name and namespace. If the Secret is not found or the Get operation
+// fails, an error will be returned.
+func (l *loader) GetSecret(ctx context.Context, cli client.Client, name, namespace string) (*corev1.Secret, error) {
+	secret := &corev1.Secret{}
+	return secret, toolkit.GetObject(name, namespace, cli, ctx, secret)
+}
+
+// GetServiceAccount returns the ServiceAccount with the given name and namespace. If the ServiceAccount is not found or
+// the Get operation fails, an error will be returned.
+func (l *loader) GetServiceAccount(ctx context.Context, cli client.Client, name, namespace string) (*corev1.ServiceAccount, error) {
+	serviceAccount := &corev1.ServiceAccount{}
+	return serviceAccount, toolkit.GetObject(name, namespace, cli, ctx, serviceAccount)
+}
+
 // GetSnapshot returns the Snapshot referenced by the given Release. If the Snapshot is not found or the Get
 // operation fails, an error is returned.
 func (l *loader) GetSnapshot(ctx context.Context, cli client.Client, release *v1alpha1.Release) (*applicationapiv1alpha1.Snapshot, error) {
@@ -332,6 +426,20 @@ func (l *loader) GetSnapshot(ctx context.Context, cli client.Client, release *v1
 	return snapshot, toolkit.GetObject(release.Spec.Snapshot, release.Namespace, cli, ctx, snapshot)
 }
 
+// GetSnapshotReleases returns a list of all the Releases in the namespace of the given Snapshot that reference it. If
+// the List operation fails, an error will be returned.
+func (l *loader) GetSnapshotReleases(ctx context.Context, cli client.Client, snapshot *applicationapiv1alpha1.Snapshot) (*v1alpha1.ReleaseList, error) {
+	releases := &v1alpha1.ReleaseList{}
+	err := cli.List(ctx, releases,
+		client.InNamespace(snapshot.Namespace),
+		client.MatchingFields{"spec.snapshot": snapshot.Name})
+	if err != nil {
+		return nil, err
+	}
+
+	return releases, nil
+}
+
 // ProcessingResources contains the required resources to process the Release.
 type ProcessingResources struct {
 	EnterpriseContractConfigMap *corev1.ConfigMap
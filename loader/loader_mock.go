@@ -18,19 +18,26 @@ import (
 const (
 	ApplicationComponentsContextKey toolkit.ContextKey = iota
 	ApplicationContextKey
+	AutoReleasePlansContextKey
 	EnterpriseContractConfigMapContextKey
 	EnterpriseContractPolicyContextKey
 	MatchedReleasePlansContextKey
 	MatchedReleasePlanAdmissionContextKey
 	PreviousReleaseContextKey
 	ProcessingResourcesContextKey
+	QueuedReleasesContextKey
 	ReleaseContextKey
 	ReleasePipelineRunContextKey
+	ReleasePipelineRunsContextKey
 	ReleasePlanAdmissionContextKey
+	ReleasePlanAdmissionPipelineRunsContextKey
 	ReleasePlanContextKey
 	ReleaseServiceConfigContextKey
 	RoleBindingContextKey
+	SecretContextKey
+	ServiceAccountContextKey
 	SnapshotContextKey
+	SnapshotReleasesContextKey
 )
 
 type mockLoader struct {
@@ -67,6 +74,14 @@ func (l *mockLoader) GetApplication(ctx context.Context, cli client.Client, rele
 	return toolkit.GetMockedResourceAndErrorFromContext(ctx, ApplicationContextKey, &applicationapiv1alpha1.Application{})
 }
 
+// GetAutoReleasePlans returns the resource and error passed as values of the context.
+func (l *mockLoader) GetAutoReleasePlans(ctx context.Context, cli client.Client, snapshot *applicationapiv1alpha1.Snapshot) (*v1alpha1.ReleasePlanList, error) {
+	if ctx.Value(AutoReleasePlansContextKey) == nil {
+		return l.loader.GetAutoReleasePlans(ctx, cli, snapshot)
+	}
+	return toolkit.GetMockedResourceAndErrorFromContext(ctx, AutoReleasePlansContextKey, &v1alpha1.ReleasePlanList{})
+}
+
 // GetEnterpriseContractPolicy returns the resource and error passed as values of the context.
 func (l *mockLoader) GetEnterpriseContractPolicy(ctx context.Context, cli client.Client, releasePlanAdmission *v1alpha1.ReleasePlanAdmission) (*ecapiv1alpha1.EnterpriseContractPolicy, error) {
 	if ctx.Value(EnterpriseContractPolicyContextKey) == nil {
@@ -107,6 +122,14 @@ func (l *mockLoader) GetPreviousRelease(ctx context.Context, cli client.Client,
 	return toolkit.GetMockedResourceAndErrorFromContext(ctx, PreviousReleaseContextKey, &v1alpha1.Release{})
 }
 
+// GetQueuedReleases returns the resource and error passed as values of the context.
+func (l *mockLoader) GetQueuedReleases(ctx context.Context, cli client.Client, releasePlanAdmission *v1alpha1.ReleasePlanAdmission) (*v1alpha1.ReleaseList, error) {
+	if ctx.Value(QueuedReleasesContextKey) == nil {
+		return l.loader.GetQueuedReleases(ctx, cli, releasePlanAdmission)
+	}
+	return toolkit.GetMockedResourceAndErrorFromContext(ctx, QueuedReleasesContextKey, &v1alpha1.ReleaseList{})
+}
+
 // GetRelease returns the resource and error passed as values of the context.
 func (l *mockLoader) GetRelease(ctx context.Context, cli client.Client, name, namespace string) (*v1alpha1.Release, error) {
 	if ctx.Value(ReleaseContextKey) == nil {
@@ -131,6 +154,14 @@ func (l *mockLoader) GetReleasePipelineRun(ctx context.Context, cli client.Clien
 	return toolkit.GetMockedResourceAndErrorFromContext(ctx, ReleasePipelineRunContextKey, &tektonv1.PipelineRun{})
 }
 
+// GetReleasePipelineRuns returns the resource and error passed as values of the context.
+func (l *mockLoader) GetReleasePipelineRuns(ctx context.Context, cli client.Client, release *v1alpha1.Release) (*tektonv1.PipelineRunList, error) {
+	if ctx.Value(ReleasePipelineRunsContextKey) == nil {
+		return l.loader.GetReleasePipelineRuns(ctx, cli, release)
+	}
+	return toolkit.GetMockedResourceAndErrorFromContext(ctx, ReleasePipelineRunsContextKey, &tektonv1.PipelineRunList{})
+}
+
 // GetReleasePlan returns the resource and error passed as values of the context.
 func (l *mockLoader) GetReleasePlan(ctx context.Context, cli client.Client, release *v1alpha1.Release) (*v1alpha1.ReleasePlan, error) {
 	if ctx.Value(ReleasePlanContextKey) == nil {
@@ -139,6 +170,14 @@ func (l *mockLoader) GetReleasePlan(ctx context.Context, cli client.Client, rele
 	return toolkit.GetMockedResourceAndErrorFromContext(ctx, ReleasePlanContextKey, &v1alpha1.ReleasePlan{})
 }
 
+// GetReleasePlanAdmissionPipelineRuns returns the resource and error passed as values of the context.
+func (l *mockLoader) GetReleasePlanAdmissionPipelineRuns(ctx context.Context, cli client.Client, releasePlanAdmission *v1alpha1.ReleasePlanAdmission) (*tektonv1.PipelineRunList, error) {
+	if ctx.Value(ReleasePlanAdmissionPipelineRunsContextKey) == nil {
+		return l.loader.GetReleasePlanAdmissionPipelineRuns(ctx, cli, releasePlanAdmission)
+	}
+	return toolkit.GetMockedResourceAndErrorFromContext(ctx, ReleasePlanAdmissionPipelineRunsContextKey, &tektonv1.PipelineRunList{})
+}
+
 // GetReleaseServiceConfig returns the resource and error passed as values of the context.
 func (l *mockLoader) GetReleaseServiceConfig(ctx context.Context, cli client.Client, name, namespace string) (*v1alpha1.ReleaseServiceConfig, error) {
 	if ctx.Value(ReleaseServiceConfigContextKey) == nil {
@@ -147,6 +186,22 @@ func (l *mockLoader) GetReleaseServiceConfig(ctx context.Context, cli client.Cli
 	return toolkit.GetMockedResourceAndErrorFromContext(ctx, ReleaseServiceConfigContextKey, &v1alpha1.ReleaseServiceConfig{})
 }
 
+// GetSecret returns the resource and error passed as values of the context.
+func (l *mockLoader) GetSecret(ctx context.Context, cli client.Client, name, namespace string) (*corev1.Secret, error) {
+	if ctx.Value(SecretContextKey) == nil {
+		return l.loader.GetSecret(ctx, cli, name, namespace)
+	}
+	return toolkit.GetMockedResourceAndErrorFromContext(ctx, SecretContextKey, &corev1.Secret{})
+}
+
+// GetServiceAccount returns the resource and error passed as values of the context.
+func (l *mockLoader) GetServiceAccount(ctx context.Context, cli client.Client, name, namespace string) (*corev1.ServiceAccount, error) {
+	if ctx.Value(ServiceAccountContextKey) == nil {
+		return l.loader.GetServiceAccount(ctx, cli, name, namespace)
+	}
+	return toolkit.GetMockedResourceAndErrorFromContext(ctx, ServiceAccountContextKey, &corev1.ServiceAccount{})
+}
+
 // GetSnapshot returns the resource and error passed as values of the context.
 func (l *mockLoader) GetSnapshot(ctx context.Context, cli client.Client, release *v1alpha1.Release) (*applicationapiv1alpha1.Snapshot, error) {
 	if ctx.Value(SnapshotContextKey) == nil {
@@ -155,6 +210,14 @@ func (l *mockLoader) GetSnapshot(ctx context.Context, cli client.Client, release
 	return toolkit.GetMockedResourceAndErrorFromContext(ctx, SnapshotContextKey, &applicationapiv1alpha1.Snapshot{})
 }
 
+// GetSnapshotReleases returns the resource and error passed as values of the context.
+func (l *mockLoader) GetSnapshotReleases(ctx context.Context, cli client.Client, snapshot *applicationapiv1alpha1.Snapshot) (*v1alpha1.ReleaseList, error) {
+	if ctx.Value(SnapshotReleasesContextKey) == nil {
+		return l.loader.GetSnapshotReleases(ctx, cli, snapshot)
+	}
+	return toolkit.GetMockedResourceAndErrorFromContext(ctx, SnapshotReleasesContextKey, &v1alpha1.ReleaseList{})
+}
+
 // Composite functions
 
 // GetProcessingResources returns the resource and error passed as values of the context.
@@ -10,6 +10,7 @@ import (
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	tektonv1 "github.com/tektoncd/pipeline/pkg/apis/pipeline/v1"
+	corev1 "k8s.io/api/core/v1"
 	rbac "k8s.io/api/rbac/v1"
 )
 
@@ -67,6 +68,21 @@ var _ = Describe("Release Adapter", Ordered, func() {
 		})
 	})
 
+	When("calling GetAutoReleasePlans", func() {
+		It("returns the resource and error from the context", func() {
+			releasePlans := &v1alpha1.ReleasePlanList{}
+			mockContext := toolkit.GetMockedContext(ctx, []toolkit.MockData{
+				{
+					ContextKey: AutoReleasePlansContextKey,
+					Resource:   releasePlans,
+				},
+			})
+			resource, err := loader.GetAutoReleasePlans(mockContext, nil, nil)
+			Expect(resource).To(Equal(releasePlans))
+			Expect(err).To(BeNil())
+		})
+	})
+
 	When("calling GetEnterpriseContractPolicy", func() {
 		It("returns the resource and error from the context", func() {
 			enterpriseContractPolicy := &v1alpha12.EnterpriseContractPolicy{}
@@ -127,6 +143,21 @@ var _ = Describe("Release Adapter", Ordered, func() {
 		})
 	})
 
+	When("calling GetQueuedReleases", func() {
+		It("returns the resource and error from the context", func() {
+			releases := &v1alpha1.ReleaseList{}
+			mockContext := toolkit.GetMockedContext(ctx, []toolkit.MockData{
+				{
+					ContextKey: QueuedReleasesContextKey,
+					Resource:   releases,
+				},
+			})
+			resource, err := loader.GetQueuedReleases(mockContext, nil, nil)
+			Expect(resource).To(Equal(releases))
+			Expect(err).To(BeNil())
+		})
+	})
+
 	When("calling GetRelease", func() {
 		It("returns the resource and error from the context", func() {
 			release := &v1alpha1.Release{}
@@ -198,6 +229,21 @@ var _ = Describe("Release Adapter", Ordered, func() {
 		})
 	})
 
+	When("calling GetReleasePipelineRuns", func() {
+		It("returns the resource and error from the context", func() {
+			pipelineRuns := &tektonv1.PipelineRunList{}
+			mockContext := toolkit.GetMockedContext(ctx, []toolkit.MockData{
+				{
+					ContextKey: ReleasePipelineRunsContextKey,
+					Resource:   pipelineRuns,
+				},
+			})
+			resource, err := loader.GetReleasePipelineRuns(mockContext, nil, nil)
+			Expect(resource).To(Equal(pipelineRuns))
+			Expect(err).To(BeNil())
+		})
+	})
+
 	When("calling GetReleasePlan", func() {
 		It("returns the resource and error from the context", func() {
 			releasePlan := &v1alpha1.ReleasePlan{}
@@ -213,6 +259,21 @@ var _ = Describe("Release Adapter", Ordered, func() {
 		})
 	})
 
+	When("calling GetReleasePlanAdmissionPipelineRuns", func() {
+		It("returns the resource and error from the context", func() {
+			pipelineRuns := &tektonv1.PipelineRunList{}
+			mockContext := toolkit.GetMockedContext(ctx, []toolkit.MockData{
+				{
+					ContextKey: ReleasePlanAdmissionPipelineRunsContextKey,
+					Resource:   pipelineRuns,
+				},
+			})
+			resource, err := loader.GetReleasePlanAdmissionPipelineRuns(mockContext, nil, nil)
+			Expect(resource).To(Equal(pipelineRuns))
+			Expect(err).To(BeNil())
+		})
+	})
+
 	When("calling GetReleaseServiceConfig", func() {
 		It("returns the resource and error from the context", func() {
 			releaseServiceConfig := &v1alpha1.ReleaseServiceConfig{}
@@ -228,6 +289,36 @@ var _ = Describe("Release Adapter", Ordered, func() {
 		})
 	})
 
+	When("calling GetSecret", func() {
+		It("returns the resource and error from the context", func() {
+			secret := &corev1.Secret{}
+			mockContext := toolkit.GetMockedContext(ctx, []toolkit.MockData{
+				{
+					ContextKey: SecretContextKey,
+					Resource:   secret,
+				},
+			})
+			resource, err := loader.GetSecret(mockContext, nil, "", "")
+			Expect(resource).To(Equal(secret))
+			Expect(err).To(BeNil())
+		})
+	})
+
+	When("calling GetServiceAccount", func() {
+		It("returns the resource and error from the context", func() {
+			serviceAccount := &corev1.ServiceAccount{}
+			mockContext := toolkit.GetMockedContext(ctx, []toolkit.MockData{
+				{
+					ContextKey: ServiceAccountContextKey,
+					Resource:   serviceAccount,
+				},
+			})
+			resource, err := loader.GetServiceAccount(mockContext, nil, "", "")
+			Expect(resource).To(Equal(serviceAccount))
+			Expect(err).To(BeNil())
+		})
+	})
+
 	When("calling GetSnapshot", func() {
 		It("returns the resource and error from the context", func() {
 			snapshot := &applicationapiv1alpha1.Snapshot{}
@@ -243,6 +334,21 @@ var _ = Describe("Release Adapter", Ordered, func() {
 		})
 	})
 
+	When("calling GetSnapshotReleases", func() {
+		It("returns the resource and error from the context", func() {
+			releases := &v1alpha1.ReleaseList{}
+			mockContext := toolkit.GetMockedContext(ctx, []toolkit.MockData{
+				{
+					ContextKey: SnapshotReleasesContextKey,
+					Resource:   releases,
+				},
+			})
+			resource, err := loader.GetSnapshotReleases(mockContext, nil, nil)
+			Expect(resource).To(Equal(releases))
+			Expect(err).To(BeNil())
+		})
+	})
+
 	// Composite functions
 
 	When("calling GetProcessingResources", func() {
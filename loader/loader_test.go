@@ -114,6 +114,36 @@ var _ = Describe("Release Adapter", Ordered, func() {
 		})
 	})
 
+	When("calling GetAutoReleasePlans", func() {
+		var autoReleasePlan, autoReleasePlanDiffApp *v1alpha1.ReleasePlan
+
+		BeforeEach(func() {
+			autoReleasePlan = releasePlan.DeepCopy()
+			autoReleasePlan.Name = "rp-auto-release"
+			autoReleasePlan.Labels = map[string]string{
+				metadata.AutoReleaseLabel: "true",
+			}
+			autoReleasePlan.ResourceVersion = ""
+			autoReleasePlanDiffApp = autoReleasePlan.DeepCopy()
+			autoReleasePlanDiffApp.Name = "rp-auto-release-diff"
+			autoReleasePlanDiffApp.Spec.Application = "some-other-app"
+			Expect(k8sClient.Create(ctx, autoReleasePlan)).To(Succeed())
+			Expect(k8sClient.Create(ctx, autoReleasePlanDiffApp)).To(Succeed())
+		})
+
+		AfterEach(func() {
+			Expect(k8sClient.Delete(ctx, autoReleasePlan)).To(Succeed())
+			Expect(k8sClient.Delete(ctx, autoReleasePlanDiffApp)).To(Succeed())
+		})
+
+		It("returns only the ReleasePlans with auto-release enabled for the Snapshot application", func() {
+			Eventually(func() bool {
+				returnedObject, err := loader.GetAutoReleasePlans(ctx, k8sClient, snapshot)
+				return err == nil && len(returnedObject.Items) == 1 && returnedObject.Items[0].Name == autoReleasePlan.Name
+			}).Should(BeTrue())
+		})
+	})
+
 	When("calling GetEnterpriseContractConfigMap", func() {
 		It("returns nil when the ENTERPRISE_CONTRACT_CONFIG_MAP variable is not set", func() {
 			os.Unsetenv("ENTERPRISE_CONTRACT_CONFIG_MAP")
@@ -210,6 +240,7 @@ var _ = Describe("Release Adapter", Ordered, func() {
 			returnedObject, err := loader.GetMatchingReleasePlanAdmission(ctx, k8sClient, modifiedReleasePlan)
 			Expect(err).To(HaveOccurred())
 			Expect(err.Error()).To(ContainSubstring("no ReleasePlanAdmission found in namespace"))
+			Expect(stderrors.Is(err, ErrReleasePlanAdmissionNotFound)).To(BeTrue())
 			Expect(returnedObject).To(BeNil())
 		})
 
@@ -220,6 +251,7 @@ var _ = Describe("Release Adapter", Ordered, func() {
 			returnedObject, err := loader.GetMatchingReleasePlanAdmission(ctx, k8sClient, modifiedReleasePlan)
 			Expect(err).To(HaveOccurred())
 			Expect(err.Error()).To(ContainSubstring("has no target"))
+			Expect(stderrors.Is(err, ErrReleasePlanHasNoTarget)).To(BeTrue())
 			Expect(returnedObject).To(BeNil())
 		})
 
@@ -395,6 +427,31 @@ var _ = Describe("Release Adapter", Ordered, func() {
 		})
 	})
 
+	When("calling GetQueuedReleases", func() {
+		It("returns the Releases queued for the ReleasePlanAdmission", func() {
+			queuedRelease := release.DeepCopy()
+			patch := client.MergeFrom(queuedRelease.DeepCopy())
+			queuedRelease.Status.Queue = fmt.Sprintf("%s/%s", releasePlanAdmission.Namespace, releasePlanAdmission.Name)
+			Expect(k8sClient.Status().Patch(ctx, queuedRelease, patch)).To(Succeed())
+			defer func() {
+				patch := client.MergeFrom(queuedRelease.DeepCopy())
+				queuedRelease.Status.Queue = ""
+				Expect(k8sClient.Status().Patch(ctx, queuedRelease, patch)).To(Succeed())
+			}()
+
+			Eventually(func() bool {
+				returnedObject, err := loader.GetQueuedReleases(ctx, k8sClient, releasePlanAdmission)
+				return err == nil && len(returnedObject.Items) == 1 && returnedObject.Items[0].Name == release.Name
+			}).Should(BeTrue())
+		})
+
+		It("returns an empty list if no Release is queued for the ReleasePlanAdmission", func() {
+			returnedObject, err := loader.GetQueuedReleases(ctx, k8sClient, releasePlanAdmission)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(returnedObject.Items).To(BeEmpty())
+		})
+	})
+
 	When("calling GetRelease", func() {
 		It("returns the requested release", func() {
 			returnedObject, err := loader.GetRelease(ctx, k8sClient, release.Name, release.Namespace)
@@ -483,6 +540,25 @@ var _ = Describe("Release Adapter", Ordered, func() {
 			Expect(returnedObject.Name).To(Equal(tenantPipelineRun.Name))
 		})
 
+		It("returns the most recent PipelineRun if more than one matches with the release data", func() {
+			// CreationTimestamp has a resolution of one second
+			time.Sleep(time.Second)
+			newerPipelineRun := managedPipelineRun.DeepCopy()
+			newerPipelineRun.ObjectMeta = metav1.ObjectMeta{
+				Labels:    managedPipelineRun.Labels,
+				Name:      "newer-managed-pipeline-run",
+				Namespace: managedPipelineRun.Namespace,
+			}
+			Expect(k8sClient.Create(ctx, newerPipelineRun)).To(Succeed())
+			defer func() {
+				Expect(k8sClient.Delete(ctx, newerPipelineRun)).To(Succeed())
+			}()
+
+			returnedObject, err := loader.GetReleasePipelineRun(ctx, k8sClient, release, metadata.ManagedPipelineType)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(returnedObject.Name).To(Equal(newerPipelineRun.Name))
+		})
+
 		It("fails to return a PipelineRun if the labels don't match with the release data", func() {
 			modifiedRelease := release.DeepCopy()
 			modifiedRelease.Name = "non-existing-release"
@@ -493,6 +569,23 @@ var _ = Describe("Release Adapter", Ordered, func() {
 		})
 	})
 
+	When("calling GetReleasePipelineRuns", func() {
+		It("returns all the PipelineRuns associated with the Release", func() {
+			returnedObject, err := loader.GetReleasePipelineRuns(ctx, k8sClient, release)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(returnedObject.Items).To(HaveLen(5))
+		})
+
+		It("returns an empty list if no PipelineRun is associated with the Release", func() {
+			modifiedRelease := release.DeepCopy()
+			modifiedRelease.Name = "non-existing-release"
+
+			returnedObject, err := loader.GetReleasePipelineRuns(ctx, k8sClient, modifiedRelease)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(returnedObject.Items).To(BeEmpty())
+		})
+	})
+
 	When("calling GetReleasePlan", func() {
 		It("returns the requested release plan", func() {
 			returnedObject, err := loader.GetReleasePlan(ctx, k8sClient, release)
@@ -502,6 +595,36 @@ var _ = Describe("Release Adapter", Ordered, func() {
 		})
 	})
 
+	When("calling GetReleasePlanAdmissionPipelineRuns", func() {
+		It("returns the managed PipelineRuns created for the ReleasePlanAdmission", func() {
+			pipelineRun := &tektonv1.PipelineRun{
+				ObjectMeta: metav1.ObjectMeta{
+					Labels: map[string]string{
+						metadata.PipelinesTypeLabel:        metadata.ManagedPipelineType.String(),
+						metadata.ReleasePlanAdmissionLabel: releasePlanAdmission.Name,
+					},
+					Name:      "admission-pipeline-run",
+					Namespace: releasePlanAdmission.Namespace,
+				},
+			}
+			Expect(k8sClient.Create(ctx, pipelineRun)).To(Succeed())
+			defer func() {
+				Expect(k8sClient.Delete(ctx, pipelineRun)).To(Succeed())
+			}()
+
+			Eventually(func() bool {
+				returnedObject, err := loader.GetReleasePlanAdmissionPipelineRuns(ctx, k8sClient, releasePlanAdmission)
+				return err == nil && len(returnedObject.Items) == 1 && returnedObject.Items[0].Name == pipelineRun.Name
+			}).Should(BeTrue())
+		})
+
+		It("returns an empty list if no PipelineRun was created for the ReleasePlanAdmission", func() {
+			returnedObject, err := loader.GetReleasePlanAdmissionPipelineRuns(ctx, k8sClient, releasePlanAdmission)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(returnedObject.Items).To(BeEmpty())
+		})
+	})
+
 	When("calling GetReleaseServiceConfig", func() {
 		It("returns the requested ReleaseServiceConfig", func() {
 			returnedObject, err := loader.GetReleaseServiceConfig(ctx, k8sClient, releaseServiceConfig.Name, releaseServiceConfig.Namespace)
@@ -511,6 +634,50 @@ var _ = Describe("Release Adapter", Ordered, func() {
 		})
 	})
 
+	When("calling GetSecret", func() {
+		It("returns the requested Secret", func() {
+			secret := &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "secret",
+					Namespace: "default",
+				},
+			}
+			Expect(k8sClient.Create(ctx, secret)).To(Succeed())
+			DeferCleanup(k8sClient.Delete, ctx, secret)
+
+			returnedObject, err := loader.GetSecret(ctx, k8sClient, secret.Name, secret.Namespace)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(returnedObject.Name).To(Equal(secret.Name))
+		})
+
+		It("returns an error if the Secret doesn't exist", func() {
+			_, err := loader.GetSecret(ctx, k8sClient, "non-existent", "default")
+			Expect(errors.IsNotFound(err)).To(BeTrue())
+		})
+	})
+
+	When("calling GetServiceAccount", func() {
+		It("returns the requested ServiceAccount", func() {
+			serviceAccount := &corev1.ServiceAccount{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "service-account",
+					Namespace: "default",
+				},
+			}
+			Expect(k8sClient.Create(ctx, serviceAccount)).To(Succeed())
+			DeferCleanup(k8sClient.Delete, ctx, serviceAccount)
+
+			returnedObject, err := loader.GetServiceAccount(ctx, k8sClient, serviceAccount.Name, serviceAccount.Namespace)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(returnedObject.Name).To(Equal(serviceAccount.Name))
+		})
+
+		It("returns an error if the ServiceAccount doesn't exist", func() {
+			_, err := loader.GetServiceAccount(ctx, k8sClient, "non-existent", "default")
+			Expect(errors.IsNotFound(err)).To(BeTrue())
+		})
+	})
+
 	When("calling GetSnapshot", func() {
 		It("returns the requested snapshot", func() {
 			returnedObject, err := loader.GetSnapshot(ctx, k8sClient, release)
@@ -520,6 +687,29 @@ var _ = Describe("Release Adapter", Ordered, func() {
 		})
 	})
 
+	When("calling GetSnapshotReleases", func() {
+		var otherRelease *v1alpha1.Release
+
+		BeforeEach(func() {
+			otherRelease = release.DeepCopy()
+			otherRelease.Name = "release-other-snapshot"
+			otherRelease.Spec.Snapshot = "other-snapshot"
+			otherRelease.ResourceVersion = ""
+			Expect(k8sClient.Create(ctx, otherRelease)).To(Succeed())
+		})
+
+		AfterEach(func() {
+			Expect(k8sClient.Delete(ctx, otherRelease)).To(Succeed())
+		})
+
+		It("returns only the Releases referencing the Snapshot", func() {
+			Eventually(func() bool {
+				returnedObject, err := loader.GetSnapshotReleases(ctx, k8sClient, snapshot)
+				return err == nil && len(returnedObject.Items) == 1 && returnedObject.Items[0].Name == release.Name
+			}).Should(BeTrue())
+		})
+	})
+
 	// Composite functions
 
 	When("calling GetProcessingResources", func() {
@@ -115,6 +115,7 @@ var _ = BeforeSuite(func() {
 
 		Expect(cache.SetupComponentCache(mgr)).To(Succeed())
 		Expect(cache.SetupReleaseCache(mgr)).To(Succeed())
+		Expect(cache.SetupReleaseSnapshotCache(mgr)).To(Succeed())
 		Expect(cache.SetupReleasePlanCache(mgr)).To(Succeed())
 		Expect(cache.SetupReleasePlanAdmissionCache(mgr)).To(Succeed())
 
@@ -19,7 +19,10 @@ package main
 import (
 	"crypto/tls"
 	"flag"
+	"fmt"
 	"os"
+	"strconv"
+	"strings"
 	"time"
 
 	"sigs.k8s.io/controller-runtime/pkg/metrics/filters"
@@ -39,6 +42,7 @@ import (
 	ecapiv1alpha1 "github.com/conforma/crds/api/v1alpha1"
 	applicationapiv1alpha1 "github.com/konflux-ci/application-api/api/v1alpha1"
 
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
@@ -54,6 +58,7 @@ import (
 
 	appstudiov1alpha1 "github.com/konflux-ci/release-service/api/v1alpha1"
 	"github.com/konflux-ci/release-service/controllers"
+	"github.com/konflux-ci/release-service/tekton/utils"
 	//+kubebuilder:scaffold:imports
 )
 
@@ -74,15 +79,17 @@ func init() {
 
 func main() {
 	var (
-		enableHTTP2              bool
-		enableLeaderElection     bool
-		leaderElectorRetryPeriod time.Duration
-		leaderRenewDeadline      time.Duration
-		leaseDuration            time.Duration
-		metricsAddr              string
-		probeAddr                string
-		secureMetrics            bool
-		tlsOpts                  []func(*tls.Config)
+		enableHTTP2                            bool
+		enableLeaderElection                   bool
+		leaderElectorRetryPeriod               time.Duration
+		leaderRenewDeadline                    time.Duration
+		leaseDuration                          time.Duration
+		metricsAddr                            string
+		missingReleasePlanAdmissionGracePeriod time.Duration
+		probeAddr                              string
+		processingDeadline                     time.Duration
+		secureMetrics                          bool
+		tlsOpts                                []func(*tls.Config)
 	)
 
 	flag.StringVar(&metricsAddr, "metrics-bind-address", ":8080", "The address the metric endpoint binds to.")
@@ -99,6 +106,11 @@ func main() {
 		"Lease Duration is the duration that non-leader candidates will wait to force acquire leadership.")
 	flag.DurationVar(&leaderElectorRetryPeriod, "leader-elector-retry-period", 2*time.Second, "RetryPeriod is the duration the "+
 		"LeaderElector clients should wait between tries of actions.")
+	flag.DurationVar(&missingReleasePlanAdmissionGracePeriod, "missing-release-plan-admission-grace-period", 10*time.Minute,
+		"The time a Release waits for its ReleasePlanAdmission to be created before failing its validation. "+
+			"Zero fails it immediately.")
+	flag.DurationVar(&processingDeadline, "release-processing-deadline", 0, "The maximum time a Release can be "+
+		"processed before its PipelineRun is cancelled and the Release is marked as failed. Zero disables it.")
 	opts := zap.Options{
 		Development: true,
 		TimeEncoder: zapcore.ISO8601TimeEncoder,
@@ -168,6 +180,12 @@ func main() {
 				&applicationapiv1alpha1.Application{}:     {},
 			},
 		},
+		Client: client.Options{
+			Cache: &client.CacheOptions{
+				// the operator is only allowed to get Secrets, so they are read directly from the API server.
+				DisableFor: []client.Object{&corev1.Secret{}},
+			},
+		},
 		HealthProbeBindAddress: probeAddr,
 		LeaderElection:         enableLeaderElection,
 		LeaderElectionID:       "f3d4c01a.redhat.com",
@@ -183,34 +201,15 @@ func main() {
 		os.Exit(1)
 	}
 
-	// Set a default value for the DEFAULT_RELEASE_PVC environment variable
-	if os.Getenv("DEFAULT_RELEASE_PVC") == "" {
-		err := os.Setenv("DEFAULT_RELEASE_PVC", "release-pvc")
-		if err != nil {
-			setupLog.Error(err, "unable to setup DEFAULT_RELEASE_PVC environment variable")
-			os.Exit(1)
-		}
-	}
-
-	// Set a default value for the DEFAULT_RELEASE_WORKSPACE_NAME environment variable
-	if os.Getenv("DEFAULT_RELEASE_WORKSPACE_NAME") == "" {
-		err := os.Setenv("DEFAULT_RELEASE_WORKSPACE_NAME", "release-workspace")
-		if err != nil {
-			setupLog.Error(err, "unable to setup DEFAULT_RELEASE_WORKSPACE_NAME environment variable")
-			os.Exit(1)
-		}
-	}
-
-	// Set a default value for the DEFAULT_RELEASE_WORKSPACE_SIZE environment variable
-	if os.Getenv("DEFAULT_RELEASE_WORKSPACE_SIZE") == "" {
-		err := os.Setenv("DEFAULT_RELEASE_WORKSPACE_SIZE", "1Gi")
-		if err != nil {
-			setupLog.Error(err, "unable to setup DEFAULT_RELEASE_WORKSPACE_SIZE environment variable")
-			os.Exit(1)
-		}
+	pipelineRunConfig, err := getPipelineRunConfig()
+	if err != nil {
+		setupLog.Error(err, "unable to load the release PipelineRun configuration")
+		os.Exit(1)
 	}
+	pipelineRunConfig.MissingReleasePlanAdmissionGracePeriod = missingReleasePlanAdmissionGracePeriod
+	pipelineRunConfig.ProcessingDeadline = processingDeadline
 
-	setUpControllers(mgr)
+	setUpControllers(mgr, pipelineRunConfig)
 	setUpWebhooks(mgr)
 
 	err = os.Setenv("ENTERPRISE_CONTRACT_CONFIG_MAP", "enterprise-contract-service/ec-defaults")
@@ -237,9 +236,90 @@ func main() {
 	}
 }
 
+// getPipelineRunConfig returns the defaults to use for the release PipelineRuns as set in the environment.
+func getPipelineRunConfig() (utils.PipelineRunConfig, error) {
+	config := utils.PipelineRunConfig{
+		DefaultPVC:            os.Getenv("DEFAULT_RELEASE_PVC"),
+		DefaultServiceAccount: os.Getenv("DEFAULT_RELEASE_SERVICE_ACCOUNT"),
+		WorkspaceName:         getEnvOrDefault("DEFAULT_RELEASE_WORKSPACE_NAME", "release-workspace"),
+		WorkspaceSize:         getEnvOrDefault("DEFAULT_RELEASE_WORKSPACE_SIZE", "1Gi"),
+	}
+
+	config.AnnotationPrefixes = getListEnv("RELEASE_ANNOTATION_PREFIXES")
+	config.PipelinesAsCodeExcludedAnnotations = getListEnv("PIPELINES_AS_CODE_EXCLUDED_ANNOTATIONS")
+
+	if timeout := os.Getenv("DEFAULT_RELEASE_TIMEOUT"); timeout != "" {
+		duration, err := time.ParseDuration(timeout)
+		if err != nil {
+			return config, fmt.Errorf("invalid DEFAULT_RELEASE_TIMEOUT: %w", err)
+		}
+		config.DefaultTimeout = duration
+	}
+
+	if skip := os.Getenv("SKIP_PIPELINERUN_FINALIZER"); skip != "" {
+		skipFinalizer, err := strconv.ParseBool(skip)
+		if err != nil {
+			return config, fmt.Errorf("invalid SKIP_PIPELINERUN_FINALIZER: %w", err)
+		}
+		config.SkipPipelineRunFinalizer = skipFinalizer
+	}
+
+	policyMaxParamSize, err := getPositiveIntEnv("ENTERPRISE_CONTRACT_POLICY_MAX_PARAM_SIZE")
+	if err != nil {
+		return config, err
+	}
+	config.EnterpriseContractPolicyMaxParamSize = policyMaxParamSize
+
+	maxArtifactSize, err := getPositiveIntEnv("MAX_ARTIFACT_SIZE")
+	if err != nil {
+		return config, err
+	}
+	config.MaxArtifactSize = maxArtifactSize
+
+	return config, nil
+}
+
+// getEnvOrDefault returns the value of the given environment variable or the default value if it's not set.
+func getEnvOrDefault(key, defaultValue string) string {
+	if value := os.Getenv(key); value != "" {
+		return value
+	}
+
+	return defaultValue
+}
+
+// getListEnv returns the comma separated values of the given environment variable. Blank values are skipped, so nil
+// is returned if the variable is not set.
+func getListEnv(key string) []string {
+	var values []string
+	for _, value := range strings.Split(os.Getenv(key), ",") {
+		if value = strings.TrimSpace(value); value != "" {
+			values = append(values, value)
+		}
+	}
+
+	return values
+}
+
+// getPositiveIntEnv returns the value of the given environment variable as a positive integer. Zero is returned if the
+// variable is not set and an error if its value is not a positive integer.
+func getPositiveIntEnv(key string) (int, error) {
+	value := os.Getenv(key)
+	if value == "" {
+		return 0, nil
+	}
+
+	number, err := strconv.Atoi(value)
+	if err != nil || number <= 0 {
+		return 0, fmt.Errorf("invalid %s: %q is not a positive integer", key, value)
+	}
+
+	return number, nil
+}
+
 // setUpControllers sets up controllers.
-func setUpControllers(mgr ctrl.Manager) {
-	err := controller.SetupControllers(mgr, nil, controllers.EnabledControllers...)
+func setUpControllers(mgr ctrl.Manager, pipelineRunConfig utils.PipelineRunConfig) {
+	err := controller.SetupControllers(mgr, nil, controllers.GetEnabledControllers(pipelineRunConfig)...)
 	if err != nil {
 		setupLog.Error(err, "unable to setup controllers")
 		os.Exit(1)
@@ -91,7 +91,8 @@ var (
 	// ServiceNameLabel is the label used to specify the service associated with an object
 	ServiceNameLabel = fmt.Sprintf("%s/%s", RhtapDomain, "service")
 
-	// ReleasePlanAdmissionLabel is the ReleasePlan label for the name of the ReleasePlanAdmission to use
+	// ReleasePlanAdmissionLabel is the ReleasePlan label for the name of the ReleasePlanAdmission to use. It's also set
+	// in the managed PipelineRuns to reference the ReleasePlanAdmission they were created for
 	ReleasePlanAdmissionLabel = fmt.Sprintf("release.%s/releasePlanAdmission", RhtapDomain)
 )
 
@@ -100,6 +101,9 @@ var (
 	// ApplicationNameLabel is the label used to specify the application associated with the PipelineRun
 	ApplicationNameLabel = fmt.Sprintf("%s/%s", RhtapDomain, "application")
 
+	// ComponentNameLabel is the label used to specify the component associated with the PipelineRun
+	ComponentNameLabel = fmt.Sprintf("%s/%s", RhtapDomain, "component")
+
 	// PipelinesTypeLabel is the label used to describe the type of pipeline
 	PipelinesTypeLabel = fmt.Sprintf("%s/%s", pipelinesLabelPrefix, "type")
 
@@ -112,3 +116,23 @@ var (
 	// ReleaseSnapshotLabel is the label used to specify the snapshot associated with the PipelineRun
 	ReleaseSnapshotLabel = fmt.Sprintf("%s/%s", RhtapDomain, "snapshot")
 )
+
+// Annotations used by the release api package
+var (
+	// RetryAnnotation is the annotation name used to request the processing of a failed Release to be retried
+	RetryAnnotation = fmt.Sprintf("release.%s/retry", RhtapDomain)
+)
+
+// Annotations to be used within Release PipelineRuns
+var (
+	// KeepAnnotation is the annotation name used to prevent a Release PipelineRun from being garbage collected
+	KeepAnnotation = fmt.Sprintf("release.%s/keep", RhtapDomain)
+
+	// SnapshotSpecSkippedAnnotation is the annotation name used to explain why the snapshot_spec param was not added
+	// to a PipelineRun
+	SnapshotSpecSkippedAnnotation = fmt.Sprintf("release.%s/snapshot-spec-skipped", RhtapDomain)
+
+	// TraceContextAnnotationPrefix is the prefix of the annotations storing the W3C trace context of the reconcile that
+	// created the PipelineRun. It's followed by the name of the trace context header, e.g. traceparent or tracestate.
+	TraceContextAnnotationPrefix = fmt.Sprintf("tracing.%s/", RhtapDomain)
+)
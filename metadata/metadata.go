@@ -17,10 +17,20 @@ limitations under the License.
 package metadata
 
 import (
-	"k8s.io/apimachinery/pkg/apis/meta/v1"
+	"crypto/sha256"
+	"encoding/hex"
+	"regexp"
 	"strings"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
+// invalidLabelValueCharacters matches the characters that are not allowed in a label value.
+var invalidLabelValueCharacters = regexp.MustCompile(`[^a-zA-Z0-9._-]+`)
+
+// labelValueHashLength is the number of hash characters appended to label values that had to be truncated.
+const labelValueHashLength = 8
+
 // AddAnnotations copies the map into the resource's Annotations map.
 // When the destination map is nil, then the map will be created.
 // The unexported function addEntries is called with args passed.
@@ -53,6 +63,21 @@ func GetLabelsWithPrefix(obj v1.Object, prefix string) map[string]string {
 	return filterByPrefix(obj.GetLabels(), prefix)
 }
 
+// SanitizeLabelValue returns a version of the given value that is valid as a label value. Invalid characters are
+// replaced by dashes and the value is trimmed so it starts and ends with an alphanumeric character. Values longer
+// than MaxLabelLength are truncated and suffixed with a short hash of the original value so they remain unique.
+func SanitizeLabelValue(value string) string {
+	sanitized := strings.Trim(invalidLabelValueCharacters.ReplaceAllString(value, "-"), "._-")
+	if len(sanitized) <= MaxLabelLength {
+		return sanitized
+	}
+
+	hash := sha256.Sum256([]byte(value))
+	prefix := strings.TrimRight(sanitized[:MaxLabelLength-labelValueHashLength-1], "._-")
+
+	return prefix + "-" + hex.EncodeToString(hash[:])[:labelValueHashLength]
+}
+
 // addEntries copies key/value pairs in the source map adding them into the destination map.
 // The unexported function safeCopy is used to copy, and avoids clobbering existing keys in the destination map.
 func addEntries(source, destination map[string]string) {
@@ -17,6 +17,7 @@ limitations under the License.
 package metadata
 
 import (
+	"strings"
 	"testing"
 
 	. "github.com/onsi/ginkgo/v2"
@@ -219,4 +220,27 @@ var _ = Describe("Metadata", func() {
 			})
 		})
 	})
+
+	Context("SanitizeLabelValue function", func() {
+		It("should return valid values unchanged", func() {
+			Expect(SanitizeLabelValue("my-release.1")).To(Equal("my-release.1"))
+		})
+
+		It("should replace invalid characters and trim the value", func() {
+			Expect(SanitizeLabelValue("-my release/name_")).To(Equal("my-release-name"))
+		})
+
+		It("should truncate long values adding a stable hash suffix", func() {
+			value := strings.Repeat("a", 100)
+			sanitized := SanitizeLabelValue(value)
+			Expect(sanitized).To(HaveLen(MaxLabelLength))
+			Expect(sanitized).To(HavePrefix(strings.Repeat("a", 54) + "-"))
+			Expect(SanitizeLabelValue(value)).To(Equal(sanitized))
+		})
+
+		It("should produce different values for long values sharing a prefix", func() {
+			prefix := strings.Repeat("a", 70)
+			Expect(SanitizeLabelValue(prefix + "b")).NotTo(Equal(SanitizeLabelValue(prefix + "c")))
+		})
+	})
 })
@@ -22,20 +22,22 @@ import (
 )
 
 // ReleasePipelineRunSucceededPredicate returns a predicate which filters out all objects except
-// Release PipelineRuns which have just succeeded.
+// Release PipelineRuns which have just succeeded or have been deleted. Updates to PipelineRuns that
+// had already finished are ignored, so only the transition of the Succeeded condition triggers a reconcile.
 func ReleasePipelineRunSucceededPredicate() predicate.Predicate {
 	return predicate.Funcs{
 		CreateFunc: func(createEvent event.CreateEvent) bool {
 			return false
 		},
 		DeleteFunc: func(deleteEvent event.DeleteEvent) bool {
-			return false
+			return IsReleasePipelineRun(deleteEvent.Object)
 		},
 		GenericFunc: func(genericEvent event.GenericEvent) bool {
 			return false
 		},
 		UpdateFunc: func(e event.UpdateEvent) bool {
-			return isReleasePipelineRun(e.ObjectNew) && hasPipelineSucceeded(e.ObjectNew)
+			return IsReleasePipelineRun(e.ObjectNew) && hasPipelineSucceeded(e.ObjectNew) &&
+				!hasPipelineSucceeded(e.ObjectOld)
 		},
 	}
 }
@@ -50,6 +50,22 @@ var _ = Describe("Predicates", Ordered, func() {
 			Expect(ReleasePipelineRunSucceededPredicate().Delete(contextEvent)).To(BeFalse())
 		})
 
+		It("should return true when a deleting event is received for a Release PipelineRun", func() {
+			var releasePipelineRun *v1.PipelineRun
+			releasePipelineRun, err = utils.NewPipelineRunBuilder("pipeline-run", "default").
+				WithLabels(map[string]string{
+					metadata.PipelinesTypeLabel: metadata.ManagedPipelineType.String(),
+					metadata.ReleaseNameLabel:   "release",
+				}).
+				Build()
+			Expect(err).NotTo(HaveOccurred())
+			contextEvent := event.DeleteEvent{
+				Object:             releasePipelineRun,
+				DeleteStateUnknown: true,
+			}
+			Expect(ReleasePipelineRunSucceededPredicate().Delete(contextEvent)).To(BeTrue())
+		})
+
 		It("should ignore generic events", func() {
 			contextEvent := event.GenericEvent{
 				Object: pipelineRun,
@@ -60,7 +76,10 @@ var _ = Describe("Predicates", Ordered, func() {
 		It("should return true when an updated event is received for a succeeded managed PipelineRun", func() {
 			var releasePipelineRun *v1.PipelineRun
 			releasePipelineRun, err = utils.NewPipelineRunBuilder("pipeline-run", "default").
-				WithLabels(map[string]string{metadata.PipelinesTypeLabel: metadata.ManagedPipelineType.String()}).
+				WithLabels(map[string]string{
+					metadata.PipelinesTypeLabel: metadata.ManagedPipelineType.String(),
+					metadata.ReleaseNameLabel:   "release",
+				}).
 				Build()
 			Expect(err).NotTo(HaveOccurred())
 			contextEvent := event.UpdateEvent{
@@ -73,5 +92,38 @@ var _ = Describe("Predicates", Ordered, func() {
 			releasePipelineRun.Status.MarkSucceeded("Predicate function tests", "Set it to Succeeded")
 			Expect(ReleasePipelineRunSucceededPredicate().Update(contextEvent)).To(BeTrue())
 		})
+
+		It("should return false when an updated event is received for a PipelineRun not associated with a Release", func() {
+			var releasePipelineRun *v1.PipelineRun
+			releasePipelineRun, err = utils.NewPipelineRunBuilder("pipeline-run", "default").
+				WithLabels(map[string]string{metadata.PipelinesTypeLabel: metadata.ManagedPipelineType.String()}).
+				Build()
+			Expect(err).NotTo(HaveOccurred())
+			releasePipelineRun.Status.MarkSucceeded("Predicate function tests", "Set it to Succeeded")
+			contextEvent := event.UpdateEvent{
+				ObjectOld: pipelineRun,
+				ObjectNew: releasePipelineRun,
+			}
+			Expect(ReleasePipelineRunSucceededPredicate().Update(contextEvent)).To(BeFalse())
+		})
+
+		It("should return false when an updated event is received for a PipelineRun that had already finished", func() {
+			var releasePipelineRun *v1.PipelineRun
+			releasePipelineRun, err = utils.NewPipelineRunBuilder("pipeline-run", "default").
+				WithLabels(map[string]string{
+					metadata.PipelinesTypeLabel: metadata.ManagedPipelineType.String(),
+					metadata.ReleaseNameLabel:   "release",
+				}).
+				Build()
+			Expect(err).NotTo(HaveOccurred())
+			releasePipelineRun.Status.MarkSucceeded("Predicate function tests", "Set it to Succeeded")
+			updatedPipelineRun := releasePipelineRun.DeepCopy()
+			updatedPipelineRun.Finalizers = []string{}
+			contextEvent := event.UpdateEvent{
+				ObjectOld: releasePipelineRun,
+				ObjectNew: updatedPipelineRun,
+			}
+			Expect(ReleasePipelineRunSucceededPredicate().Update(contextEvent)).To(BeFalse())
+		})
 	})
 })
@@ -17,19 +17,219 @@ limitations under the License.
 package tekton
 
 import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/konflux-ci/release-service/api/v1alpha1"
 	"github.com/konflux-ci/release-service/metadata"
+	libhandler "github.com/operator-framework/operator-lib/handler"
 	tektonv1 "github.com/tektoncd/pipeline/pkg/apis/pipeline/v1"
 	"knative.dev/pkg/apis"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
-// isReleasePipelineRun returns a boolean indicating whether the object passed is a Final, Managed or a Tenant Release PipelineRun.
-func isReleasePipelineRun(object client.Object) bool {
+const (
+	// ellipsis is appended to the messages that had to be truncated
+	ellipsis = "..."
+
+	// maxFailureMessageLength is the maximum number of characters of the failure messages, so they can be safely
+	// stored in the Release conditions
+	maxFailureMessageLength = 1024
+)
+
+// GetApplicationName returns the name of the Application associated with the given PipelineRun as set in its labels.
+// If the label is not set, an empty string is returned.
+func GetApplicationName(pipelineRun *tektonv1.PipelineRun) string {
+	return getPipelineRunLabel(pipelineRun, metadata.ApplicationNameLabel)
+}
+
+// GetPipelineName returns the name of the Pipeline executed by the given PipelineRun. If the PipelineRef doesn't set it
+// directly, the value of the "name" resolver param is returned instead. An empty string is returned if neither of them
+// is present.
+func GetPipelineName(pipelineRun *tektonv1.PipelineRun) string {
+	if pipelineRun == nil || pipelineRun.Spec.PipelineRef == nil {
+		return ""
+	}
+
+	if pipelineRun.Spec.PipelineRef.Name != "" {
+		return pipelineRun.Spec.PipelineRef.Name
+	}
+
+	for _, param := range pipelineRun.Spec.PipelineRef.Params {
+		if param.Name == "name" {
+			return param.Value.StringVal
+		}
+	}
+
+	return ""
+}
+
+// GetPipelineRunDuration returns the time elapsed between the start and the completion of the given PipelineRun. If
+// the PipelineRun is still running, the time elapsed since it started is returned. A zero duration is returned if the
+// PipelineRun has not started yet.
+func GetPipelineRunDuration(pipelineRun *tektonv1.PipelineRun) time.Duration {
+	if pipelineRun == nil || pipelineRun.Status.StartTime == nil {
+		return 0
+	}
+
+	if pipelineRun.Status.CompletionTime == nil {
+		return time.Since(pipelineRun.Status.StartTime.Time)
+	}
+
+	return pipelineRun.Status.CompletionTime.Sub(pipelineRun.Status.StartTime.Time)
+}
+
+// GetPipelineRunFailureMessage returns a message containing the name of each failed task of the given PipelineRun,
+// the exit code of its failed step and its termination message. TaskRuns that can't be fetched are ignored. If no failed
+// task can be determined, the message of the Succeeded condition of the PipelineRun is returned instead. The message is
+// truncated to maxFailureMessageLength characters so it can be safely stored in a condition. An empty string is
+// returned if the PipelineRun didn't fail.
+func GetPipelineRunFailureMessage(ctx context.Context, cli client.Client, pipelineRun *tektonv1.PipelineRun) string {
+	if !HasPipelineRunFailed(pipelineRun) {
+		return ""
+	}
+
+	var failures []string
+	for _, childReference := range pipelineRun.Status.ChildReferences {
+		if childReference.Kind != "TaskRun" {
+			continue
+		}
+
+		taskRun := &tektonv1.TaskRun{}
+		err := cli.Get(ctx, client.ObjectKey{Name: childReference.Name, Namespace: pipelineRun.Namespace}, taskRun)
+		if err != nil || !taskRun.Status.GetCondition(apis.ConditionSucceeded).IsFalse() {
+			continue
+		}
+
+		failures = append(failures, fmt.Sprintf("task %q failed: %s", childReference.PipelineTaskName,
+			getTaskRunFailureMessage(taskRun)))
+	}
+
+	if len(failures) == 0 {
+		return truncateMessage(GetPipelineRunFailureReason(pipelineRun), maxFailureMessageLength)
+	}
+
+	return truncateMessage(strings.Join(failures, "; "), maxFailureMessageLength)
+}
+
+// GetPipelineRunFailureReason returns the message of the Succeeded condition of the given PipelineRun if it failed.
+// Otherwise, an empty string is returned.
+func GetPipelineRunFailureReason(pipelineRun *tektonv1.PipelineRun) string {
+	if !HasPipelineRunFailed(pipelineRun) {
+		return ""
+	}
+
+	return pipelineRun.Status.GetCondition(apis.ConditionSucceeded).Message
+}
+
+// GetPipelineRunResult returns the value of the result with the given name from the given PipelineRun and a boolean
+// indicating whether the result was found. Array and object results are returned in their JSON representation.
+func GetPipelineRunResult(pipelineRun *tektonv1.PipelineRun, name string) (string, bool) {
+	if pipelineRun == nil {
+		return "", false
+	}
+
+	for _, result := range pipelineRun.Status.Results {
+		if result.Name == name {
+			return resultValueToString(result.Value), true
+		}
+	}
+
+	return "", false
+}
+
+// GetPipelineRunResults returns a map containing all the results of the given PipelineRun. Array and object results
+// are returned in their JSON representation.
+func GetPipelineRunResults(pipelineRun *tektonv1.PipelineRun) map[string]string {
+	results := map[string]string{}
+	if pipelineRun == nil {
+		return results
+	}
+
+	for _, result := range pipelineRun.Status.Results {
+		results[result.Name] = resultValueToString(result.Value)
+	}
+
+	return results
+}
+
+// GetReleaseName returns the name of the Release associated with the given PipelineRun as set in its labels.
+// If the label is not set, an empty string is returned.
+func GetReleaseName(pipelineRun *tektonv1.PipelineRun) string {
+	return getPipelineRunLabel(pipelineRun, metadata.ReleaseNameLabel)
+}
+
+// GetReleaseNamespace returns the namespace of the Release associated with the given PipelineRun as set in its labels.
+// If the label is not set, an empty string is returned.
+func GetReleaseNamespace(pipelineRun *tektonv1.PipelineRun) string {
+	return getPipelineRunLabel(pipelineRun, metadata.ReleaseNamespaceLabel)
+}
+
+// GetResolvedPipelineProvenance returns the source of the Pipeline executed by the given PipelineRun as resolved by
+// Tekton, in the form uri@algorithm:digest. If the PipelineRun has no provenance information yet, an empty string
+// is returned.
+func GetResolvedPipelineProvenance(pipelineRun *tektonv1.PipelineRun) string {
+	if pipelineRun == nil || pipelineRun.Status.Provenance == nil || pipelineRun.Status.Provenance.RefSource == nil {
+		return ""
+	}
+
+	refSource := pipelineRun.Status.Provenance.RefSource
+	if refSource.URI == "" || len(refSource.Digest) == 0 || strings.Contains(refSource.URI, "@") {
+		return refSource.URI
+	}
+
+	algorithm := "sha256"
+	if _, found := refSource.Digest[algorithm]; !found {
+		algorithms := make([]string, 0, len(refSource.Digest))
+		for key := range refSource.Digest {
+			algorithms = append(algorithms, key)
+		}
+		sort.Strings(algorithms)
+		algorithm = algorithms[0]
+	}
+
+	return fmt.Sprintf("%s@%s:%s", refSource.URI, algorithm, refSource.Digest[algorithm])
+}
+
+// HasPipelineRunFailed returns a boolean indicating whether the given PipelineRun finished with a failure.
+func HasPipelineRunFailed(pipelineRun *tektonv1.PipelineRun) bool {
+	return pipelineRun != nil && pipelineRun.Status.GetCondition(apis.ConditionSucceeded).IsFalse()
+}
+
+// HasPipelineRunSucceeded returns a boolean indicating whether the given PipelineRun finished successfully.
+func HasPipelineRunSucceeded(pipelineRun *tektonv1.PipelineRun) bool {
+	return pipelineRun != nil && pipelineRun.Status.GetCondition(apis.ConditionSucceeded).IsTrue()
+}
+
+// IsOwnedBy returns a boolean indicating whether the given PipelineRun was created for the given Release. That is,
+// whether its owner annotations, as set by the PipelineRunBuilder WithOwner method, refer to that Release.
+func IsOwnedBy(pipelineRun *tektonv1.PipelineRun, release *v1alpha1.Release) bool {
+	if pipelineRun == nil || release == nil {
+		return false
+	}
+
+	annotations := pipelineRun.GetAnnotations()
+
+	return annotations[libhandler.TypeAnnotation] == fmt.Sprintf("Release.%s", v1alpha1.GroupVersion.Group) &&
+		annotations[libhandler.NamespacedNameAnnotation] == fmt.Sprintf("%s/%s", release.Namespace, release.Name)
+}
+
+// IsReleasePipelineRun returns a boolean indicating whether the object passed is a Release PipelineRun. That is, a
+// PipelineRun of one of the Release Pipeline types which is associated with a Release.
+func IsReleasePipelineRun(object client.Object) bool {
 	_, ok := object.(*tektonv1.PipelineRun)
 	if !ok {
 		return false
 	}
 
+	if _, found := object.GetLabels()[metadata.ReleaseNameLabel]; !found {
+		return false
+	}
+
 	labelValue, found := object.GetLabels()[metadata.PipelinesTypeLabel]
 
 	return found && (labelValue == metadata.TenantCollectorsPipelineType.String() ||
@@ -48,3 +248,57 @@ func hasPipelineSucceeded(object client.Object) bool {
 
 	return false
 }
+
+// resultValueToString returns the string representation of the given result value. String values are returned as
+// they are while array and object values are encoded as JSON.
+func resultValueToString(value tektonv1.ResultValue) string {
+	if value.Type == tektonv1.ParamTypeString || value.Type == "" {
+		return value.StringVal
+	}
+
+	encoded, err := json.Marshal(value)
+	if err != nil {
+		return ""
+	}
+
+	return string(encoded)
+}
+
+// getTaskRunFailureMessage returns the exit code and termination message of the first failed step of the given TaskRun.
+// If the step didn't leave a termination message, the message of the Succeeded condition of the TaskRun is used
+// instead, which is also returned alone if no failed step is found.
+func getTaskRunFailureMessage(taskRun *tektonv1.TaskRun) string {
+	message := taskRun.Status.GetCondition(apis.ConditionSucceeded).Message
+
+	for _, step := range taskRun.Status.Steps {
+		if step.Terminated != nil && step.Terminated.ExitCode != 0 {
+			if step.Terminated.Message != "" {
+				message = step.Terminated.Message
+			}
+			return fmt.Sprintf("exit code %d: %s", step.Terminated.ExitCode, message)
+		}
+	}
+
+	return message
+}
+
+// getPipelineRunLabel returns the value of the label with the given key from the given PipelineRun. If the PipelineRun
+// is nil or the label is not set, an empty string is returned.
+func getPipelineRunLabel(pipelineRun *tektonv1.PipelineRun, key string) string {
+	if pipelineRun == nil {
+		return ""
+	}
+
+	return pipelineRun.GetLabels()[key]
+}
+
+// truncateMessage returns the given message cut to the given number of characters, ending with an ellipsis if it had
+// to be truncated.
+func truncateMessage(message string, length int) string {
+	runes := []rune(message)
+	if len(runes) <= length {
+		return message
+	}
+
+	return string(runes[:length-len(ellipsis)]) + ellipsis
+}
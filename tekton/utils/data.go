@@ -0,0 +1,62 @@
+/*
+Copyright 2023.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package utils
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// MergeData deep merges the given data objects in order, so keys found in later objects override the same keys in
+// previous ones. Nested objects are merged recursively, while any other value, including arrays and values of a
+// different type, is replaced. Nil or empty objects are ignored.
+func MergeData(data ...*runtime.RawExtension) (map[string]interface{}, error) {
+	merged := map[string]interface{}{}
+
+	for _, rawData := range data {
+		if rawData == nil || len(rawData.Raw) == 0 {
+			continue
+		}
+
+		var values map[string]interface{}
+		if err := json.Unmarshal(rawData.Raw, &values); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal data: %v", err)
+		}
+
+		merged = mergeMaps(merged, values)
+	}
+
+	return merged, nil
+}
+
+// mergeMaps returns the result of recursively merging the override map into the base map.
+func mergeMaps(base, override map[string]interface{}) map[string]interface{} {
+	for key, overrideValue := range override {
+		baseMap, baseIsMap := base[key].(map[string]interface{})
+		overrideMap, overrideIsMap := overrideValue.(map[string]interface{})
+
+		if baseIsMap && overrideIsMap {
+			base[key] = mergeMaps(baseMap, overrideMap)
+		} else {
+			base[key] = overrideValue
+		}
+	}
+
+	return base
+}
@@ -0,0 +1,80 @@
+/*
+Copyright 2023.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package utils
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+var _ = Describe("Data", func() {
+
+	When("MergeData function is called", func() {
+		It("should return an empty map if there is no data", func() {
+			merged, err := MergeData(nil, &runtime.RawExtension{})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(merged).To(BeEmpty())
+		})
+
+		It("should override the keys from previous objects", func() {
+			merged, err := MergeData(
+				&runtime.RawExtension{Raw: []byte(`{"foo": "bar", "baz": "qux"}`)},
+				&runtime.RawExtension{Raw: []byte(`{"foo": "override"}`)},
+			)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(merged).To(Equal(map[string]interface{}{"foo": "override", "baz": "qux"}))
+		})
+
+		It("should merge nested maps recursively", func() {
+			merged, err := MergeData(
+				&runtime.RawExtension{Raw: []byte(`{"advisory": {"type": "RHBA", "spec": {"cves": ["CVE-1"], "topic": "topic"}}}`)},
+				&runtime.RawExtension{Raw: []byte(`{"advisory": {"type": "RHSA", "spec": {"cves": ["CVE-2"]}}}`)},
+			)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(merged).To(Equal(map[string]interface{}{
+				"advisory": map[string]interface{}{
+					"type": "RHSA",
+					"spec": map[string]interface{}{
+						"cves":  []interface{}{"CVE-2"},
+						"topic": "topic",
+					},
+				},
+			}))
+		})
+
+		It("should replace values of a different type", func() {
+			merged, err := MergeData(
+				&runtime.RawExtension{Raw: []byte(`{"foo": {"bar": "baz"}, "qux": "value"}`)},
+				&runtime.RawExtension{Raw: []byte(`{"foo": "value", "qux": {"bar": "baz"}}`)},
+			)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(merged).To(Equal(map[string]interface{}{
+				"foo": "value",
+				"qux": map[string]interface{}{"bar": "baz"},
+			}))
+		})
+
+		It("should fail if any of the objects is not a JSON object", func() {
+			_, err := MergeData(&runtime.RawExtension{Raw: []byte(`["foo"]`)})
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("failed to unmarshal data"))
+		})
+	})
+
+})
@@ -0,0 +1,49 @@
+/*
+Copyright 2023.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package utils
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// EnterpriseContractBundleKey is the Enterprise Contract ConfigMap key containing the verify EC task bundle
+	EnterpriseContractBundleKey = "verify_ec_task_bundle"
+
+	// EnterpriseContractGitRevisionKey is the Enterprise Contract ConfigMap key containing the verify EC task git revision
+	EnterpriseContractGitRevisionKey = "verify_ec_task_git_revision"
+)
+
+// EnterpriseContractConfigMapKeys are the keys the Enterprise Contract ConfigMap must define to be passed to the
+// managed Pipeline.
+var EnterpriseContractConfigMapKeys = []string{EnterpriseContractBundleKey, EnterpriseContractGitRevisionKey}
+
+// NewEnterpriseContractConfigMap returns a ConfigMap with the given name and namespace defining all the
+// EnterpriseContractConfigMapKeys, so it can be passed to WithRequiredParamsFromConfigMap.
+func NewEnterpriseContractConfigMap(name, namespace, bundle, gitRevision string) *corev1.ConfigMap {
+	return &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: namespace,
+		},
+		Data: map[string]string{
+			EnterpriseContractBundleKey:      bundle,
+			EnterpriseContractGitRevisionKey: gitRevision,
+		},
+	}
+}
@@ -0,0 +1,49 @@
+/*
+Copyright 2023.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package utils
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Enterprise Contract", func() {
+
+	When("NewEnterpriseContractConfigMap function is called", func() {
+		It("should return a ConfigMap defining all the required keys", func() {
+			configMap := NewEnterpriseContractConfigMap("ec-defaults", "default", "test-bundle", "main")
+			Expect(configMap.Name).To(Equal("ec-defaults"))
+			Expect(configMap.Namespace).To(Equal("default"))
+			for _, key := range EnterpriseContractConfigMapKeys {
+				Expect(configMap.Data).To(HaveKey(key))
+			}
+		})
+
+		It("should produce a ConfigMap accepted by WithRequiredParamsFromConfigMap", func() {
+			configMap := NewEnterpriseContractConfigMap("ec-defaults", "default", "test-bundle", "main")
+			builder := NewPipelineRunBuilder("testPrefix", "testNamespace").
+				WithRequiredParamsFromConfigMap(configMap, EnterpriseContractConfigMapKeys...)
+
+			Expect(builder.err).To(BeNil())
+			Expect(builder.pipelineRun.Spec.Params).To(HaveLen(2))
+			Expect(builder.pipelineRun.Spec.Params[0].Name).To(Equal(EnterpriseContractBundleKey))
+			Expect(builder.pipelineRun.Spec.Params[0].Value.StringVal).To(Equal("test-bundle"))
+			Expect(builder.pipelineRun.Spec.Params[1].Name).To(Equal(EnterpriseContractGitRevisionKey))
+			Expect(builder.pipelineRun.Spec.Params[1].Value.StringVal).To(Equal("main"))
+		})
+	})
+})
@@ -18,16 +18,24 @@ package utils
 
 import (
 	"fmt"
+
 	tektonv1 "github.com/tektoncd/pipeline/pkg/apis/pipeline/v1"
+	corev1 "k8s.io/api/core/v1"
 )
 
 // Param defines the parameters for a given resolver in PipelineRef
+// +kubebuilder:object:generate=true
 type Param struct {
 	// Name is the name of the parameter
 	Name string `json:"name"`
 
+	// ObjectValues is the value of the parameter for object params. It can't be set alongside Value
+	// +optional
+	ObjectValues map[string]string `json:"objectValues,omitempty"`
+
 	// Value is the value of the parameter
-	Value string `json:"value"`
+	// +optional
+	Value string `json:"value,omitempty"`
 }
 
 // PipelineRef represents a reference to a Pipeline using a resolver.
@@ -40,24 +48,93 @@ type PipelineRef struct {
 	Params []Param `json:"params"`
 }
 
+// SecretWorkspace defines a read-only workspace backed by a Secret.
+// +kubebuilder:object:generate=true
+type SecretWorkspace struct {
+	// Name is the name of the workspace
+	Name string `json:"name"`
+
+	// SecretName is the name of the Secret mounted in the workspace
+	// +kubebuilder:validation:Pattern=^[a-z0-9]([-a-z0-9]*[a-z0-9])?$
+	SecretName string `json:"secretName"`
+}
+
+// Workspace defines an additional workspace to bind to the PipelineRun. Exactly one of its sources must be set.
+// +kubebuilder:validation:XValidation:rule="[has(self.configMap), has(self.emptyDir), has(self.persistentVolumeClaim), has(self.secret)].filter(x, x).size() == 1",message="exactly one of configMap, emptyDir, persistentVolumeClaim or secret must be set"
+// +kubebuilder:object:generate=true
+type Workspace struct {
+	// Name is the name of the workspace
+	Name string `json:"name"`
+
+	// ConfigMap is the name of the ConfigMap mounted in the workspace
+	// +optional
+	ConfigMap string `json:"configMap,omitempty"`
+
+	// EmptyDir indicates whether the workspace is backed by an EmptyDir
+	// +optional
+	EmptyDir bool `json:"emptyDir,omitempty"`
+
+	// PersistentVolumeClaim is the name of an existing PersistentVolumeClaim mounted in the workspace
+	// +optional
+	PersistentVolumeClaim string `json:"persistentVolumeClaim,omitempty"`
+
+	// Secret is the name of the Secret mounted in the workspace
+	// +optional
+	Secret string `json:"secret,omitempty"`
+}
+
 // Pipeline contains a reference to a Pipeline and the name of the service account to use while executing it.
 // +kubebuilder:object:generate=true
 type Pipeline struct {
+	// NodeSelector is a selector which must match a node's labels for the Pipeline pods to be scheduled on that node
+	// +optional
+	NodeSelector map[string]string `json:"nodeSelector,omitempty"`
+
 	// PipelineRef is the reference to the Pipeline
 	PipelineRef PipelineRef `json:"pipelineRef"`
 
+	// PriorityClassName is the name of the PriorityClass to use for the Pipeline pods
+	// +optional
+	PriorityClassName string `json:"priorityClassName,omitempty"`
+
+	// SchedulerName is the name of the scheduler to use to dispatch the Pipeline pods
+	// +optional
+	SchedulerName string `json:"schedulerName,omitempty"`
+
 	// ServiceAccountName is the ServiceAccount to use during the execution of the Pipeline
 	// +kubebuilder:validation:Pattern=^[a-z0-9]([-a-z0-9]*[a-z0-9])?$
 	// +optional
 	ServiceAccountName string `json:"serviceAccountName,omitempty"`
 
+	// SigningSecretWorkspace is a read-only workspace mounted from a Secret containing the signing keys
+	// +optional
+	SigningSecretWorkspace *SecretWorkspace `json:"signingSecretWorkspace,omitempty"`
+
 	// TaskRunSpecs is the PipelineTaskRunSpec to be used in the PipelineRun execution
 	// +optional
 	TaskRunSpecs []tektonv1.PipelineTaskRunSpec `json:"taskRunSpecs,omitempty"`
 
+	// StorageClass is the name of the StorageClass to use for the workspace volume of the PipelineRun
+	// +optional
+	StorageClass string `json:"storageClass,omitempty"`
+
 	// Timeouts defines the different Timeouts to use in the PipelineRun execution
 	// +optional
 	Timeouts tektonv1.TimeoutFields `json:"timeouts,omitempty"`
+
+	// Tolerations are the tolerations to apply to the Pipeline pods
+	// +optional
+	Tolerations []corev1.Toleration `json:"tolerations,omitempty"`
+
+	// WorkspaceSize is the size of the workspace volume of the PipelineRun
+	// +optional
+	WorkspaceSize string `json:"workspaceSize,omitempty"`
+
+	// Workspaces is a list of additional workspaces to bind to the PipelineRun alongside the default one
+	// +listType=map
+	// +listMapKey=name
+	// +optional
+	Workspaces []Workspace `json:"workspaces,omitempty"`
 }
 
 // ParameterizedPipeline is an extension of the Pipeline struct, adding an array of parameters that will be passed to
@@ -71,6 +148,46 @@ type ParameterizedPipeline struct {
 	Params []Param `json:"params,omitempty"`
 }
 
+// NewClusterPipelineRef returns a PipelineRef using the cluster resolver to reference the Pipeline with the given name
+// in the given namespace.
+func NewClusterPipelineRef(namespace, name string) *PipelineRef {
+	return &PipelineRef{
+		Resolver: "cluster",
+		Params: []Param{
+			{Name: "kind", Value: "pipeline"},
+			{Name: "name", Value: name},
+			{Name: "namespace", Value: namespace},
+		},
+	}
+}
+
+// NewGitPipelineRef returns a PipelineRef using the git resolver to reference the Pipeline found in the given url,
+// revision and pathInRepo.
+func NewGitPipelineRef(url, revision, pathInRepo string) *PipelineRef {
+	return &PipelineRef{
+		Resolver: "git",
+		Params: []Param{
+			{Name: "url", Value: url},
+			{Name: "revision", Value: revision},
+			{Name: "pathInRepo", Value: pathInRepo},
+		},
+	}
+}
+
+// NewHubPipelineRef returns a PipelineRef using the hub resolver to reference the Pipeline with the given name and
+// version in the given catalog.
+func NewHubPipelineRef(catalog, name, version string) *PipelineRef {
+	return &PipelineRef{
+		Resolver: "hub",
+		Params: []Param{
+			{Name: "catalog", Value: catalog},
+			{Name: "kind", Value: "pipeline"},
+			{Name: "name", Value: name},
+			{Name: "version", Value: version},
+		},
+	}
+}
+
 // GetGitResolverParams returns the common parameters found in a Git resolver. That is url, revision and pathInRepo.
 // If the PipelineRef doesn't use a git resolver this function will return an error.
 func (pr *PipelineRef) GetGitResolverParams() (string, string, string, error) {
@@ -120,13 +237,7 @@ func (pr *PipelineRef) ToTektonPipelineRef() *tektonv1.PipelineRef {
 	params := tektonv1.Params{}
 
 	for _, p := range pr.Params {
-		params = append(params, tektonv1.Param{
-			Name: p.Name,
-			Value: tektonv1.ParamValue{
-				Type:      tektonv1.ParamTypeString,
-				StringVal: p.Value,
-			},
-		})
+		params = append(params, toTektonParam(p))
 	}
 
 	tektonPipelineRef := &tektonv1.PipelineRef{
@@ -139,24 +250,137 @@ func (pr *PipelineRef) ToTektonPipelineRef() *tektonv1.PipelineRef {
 	return tektonPipelineRef
 }
 
+// Validate checks that the PipelineRef is not ambiguous, as ValidateUnambiguous does, and that it defines the params
+// required by its resolver. The params required by the git resolver are not checked, as it supports several modes
+// (e.g. url or repo and org) and defaults for some of them, so the resolver validates them. A cluster PipelineRef must
+// define the name and namespace params and can only reference Pipelines. A hub PipelineRef has the same restrictions
+// but requires the name and version params instead.
+func (pr *PipelineRef) Validate() error {
+	if err := pr.ValidateUnambiguous(); err != nil {
+		return err
+	}
+
+	paramNames := map[string]bool{}
+	paramValues := map[string]string{}
+	for _, param := range pr.Params {
+		paramNames[param.Name] = param.Value != ""
+		paramValues[param.Name] = param.Value
+	}
+
+	var requiredParams []string
+	switch pr.Resolver {
+	case "cluster":
+		requiredParams = []string{"name", "namespace"}
+	case "hub":
+		requiredParams = []string{"name", "version"}
+	default:
+		return nil
+	}
+
+	for _, name := range requiredParams {
+		if !paramNames[name] {
+			return fmt.Errorf("a %s PipelineRef requires the %s param to be set", pr.Resolver, name)
+		}
+	}
+	if kind, found := paramValues["kind"]; found && kind != "pipeline" {
+		return fmt.Errorf("a %s PipelineRef can only reference a pipeline, not a %s", pr.Resolver, kind)
+	}
+
+	return nil
+}
+
+// ValidateUnambiguous checks that the PipelineRef doesn't define params belonging to a resolver other than its own, so
+// it's clear which Pipeline it references. A git PipelineRef can't define a bundle, while a bundles PipelineRef can't
+// define any of the git params. Cluster and hub PipelineRefs can't define a bundle or any of the git params.
+func (pr *PipelineRef) ValidateUnambiguous() error {
+	paramNames := map[string]bool{}
+	for _, param := range pr.Params {
+		paramNames[param.Name] = true
+	}
+
+	gitParams := []string{"url", "revision", "pathInRepo"}
+
+	switch pr.Resolver {
+	case "cluster", "hub":
+		for _, name := range append(gitParams, "bundle") {
+			if paramNames[name] {
+				return fmt.Errorf("a %s PipelineRef can't define the %s param", pr.Resolver, name)
+			}
+		}
+	case "git":
+		if paramNames["bundle"] {
+			return fmt.Errorf("a git PipelineRef can't define a bundle param")
+		}
+	case "bundles":
+		for _, name := range gitParams {
+			if paramNames[name] {
+				return fmt.Errorf("a bundles PipelineRef can't define the git %s param", name)
+			}
+		}
+	}
+
+	return nil
+}
+
+// Validate checks that the Param defines at most one of Value and ObjectValues.
+func (p *Param) Validate() error {
+	if p.Value != "" && p.ObjectValues != nil {
+		return fmt.Errorf("the %s param can't define both value and objectValues", p.Name)
+	}
+
+	return nil
+}
+
 // GetTektonParams returns the ParameterizedPipeline []Param as []tektonv1.Param.
 func (prp *ParameterizedPipeline) GetTektonParams() []tektonv1.Param {
 	params := []tektonv1.Param{}
 
 	for _, param := range prp.Params {
-		params = append(params, tektonv1.Param{
-			Name: param.Name,
-			Value: tektonv1.ParamValue{
-				Type:      tektonv1.ParamTypeString,
-				StringVal: param.Value,
-			},
-		})
+		params = append(params, toTektonParam(param))
 	}
 
 	return params
 }
 
+// ValidateParams checks that all the params of the ParameterizedPipeline are valid.
+func (prp *ParameterizedPipeline) ValidateParams() error {
+	for _, param := range prp.Params {
+		if err := param.Validate(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// GetWorkspaceSize returns the WorkspaceSize of the Pipeline or the given default size if it's not set.
+func (p *Pipeline) GetWorkspaceSize(defaultSize string) string {
+	if p.WorkspaceSize != "" {
+		return p.WorkspaceSize
+	}
+
+	return defaultSize
+}
+
 // IsClusterScoped returns whether the PipelineRef uses a cluster resolver or not.
 func (pr *PipelineRef) IsClusterScoped() bool {
 	return pr.Resolver == "cluster"
 }
+
+// toTektonParam converts a Param to Tekton's own Param type.
+func toTektonParam(param Param) tektonv1.Param {
+	if param.ObjectValues != nil {
+		return tektonv1.Param{
+			Name:  param.Name,
+			Value: *tektonv1.NewObject(param.ObjectValues),
+		}
+	}
+
+	return tektonv1.Param{
+		Name: param.Name,
+		Value: tektonv1.ParamValue{
+			Type:      tektonv1.ParamTypeString,
+			StringVal: param.Value,
+		},
+	}
+}
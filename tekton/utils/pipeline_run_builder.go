@@ -17,24 +17,148 @@ limitations under the License.
 package utils
 
 import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"reflect"
+	"regexp"
+	"strings"
+	"time"
 	"unicode"
 
 	"github.com/hashicorp/go-multierror"
+	applicationapiv1alpha1 "github.com/konflux-ci/application-api/api/v1alpha1"
+	"github.com/konflux-ci/release-service/metadata"
 	libhandler "github.com/operator-framework/operator-lib/handler"
+	"github.com/tektoncd/pipeline/pkg/apis/pipeline/pod"
 	tektonv1 "github.com/tektoncd/pipeline/pkg/apis/pipeline/v1"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 )
 
+// deterministicNameHashLength is the number of hash characters added to deterministic names that had to be truncated.
+const deterministicNameHashLength = 8
+
+// CosignKeySecretParamName is the name of the param used to pass the Secret holding the key used to sign artifacts.
+const CosignKeySecretParamName = "cosign_key_secret"
+
+// maxPipelineRunNameLength is the maximum length of a PipelineRun name, as Tekton uses it as a label value in the
+// TaskRuns it creates.
+const maxPipelineRunNameLength = 63
+
+// maxSnapshotSpecSize is the maximum size in bytes of the serialized Snapshot spec that can be added as a param. It's
+// kept well below the 1.5MB etcd object limit, as the rest of the params, the TaskRuns status and the results are
+// stored in the same PipelineRun.
+const maxSnapshotSpecSize = 256 * 1024
+
+// RegistryAuthSecretParamName is the name of the param used to pass the Secret holding the registry credentials.
+const RegistryAuthSecretParamName = "registry_auth_secret"
+
+// ReleaseUIDParamName is the name of the param used to pass the UID of the Release being processed.
+const ReleaseUIDParamName = "release_uid"
+
+// RekorURLParamName is the name of the param used to pass the URL of the Rekor transparency log used when signing.
+const RekorURLParamName = "rekor_url"
+
+// digestPinnedReference matches image references pinned by a sha256 digest.
+var digestPinnedReference = regexp.MustCompile(`^[^@\s]+@sha256:[a-f0-9]{64}$`)
+
+// invalidSubPathCharacters matches the characters that are not allowed in a workspace subPath.
+var invalidSubPathCharacters = regexp.MustCompile(`[^a-zA-Z0-9._-]+`)
+
 type PipelineRunBuilder struct {
-	err         *multierror.Error
-	pipelineRun *tektonv1.PipelineRun
+	defaults         *PipelineRunConfig
+	err              *multierror.Error
+	objectReferences map[string]bool
+	pipelineRun      *tektonv1.PipelineRun
+}
+
+// PipelineRunConfig contains the service-wide defaults to use for the release PipelineRuns when they don't set their
+// own values.
+type PipelineRunConfig struct {
+	// AnnotationPrefixes are the prefixes of the Release annotations to propagate to the PipelineRuns
+	AnnotationPrefixes []string
+
+	// DefaultPVC is the name of the PersistentVolumeClaim to bind to the workspace if no workspace is set
+	DefaultPVC string
+
+	// DefaultServiceAccount is the ServiceAccount to use if none is set
+	DefaultServiceAccount string
+
+	// DefaultTimeout is the Pipeline timeout to use if none is set
+	DefaultTimeout time.Duration
+
+	// EnterpriseContractPolicyMaxParamSize is the size in bytes above which the EnterpriseContractPolicy spec is passed
+	// to the managed Pipeline through a ConfigMap instead of a param. A zero value uses the service default
+	EnterpriseContractPolicyMaxParamSize int
+
+	// MaxArtifactSize is the size in bytes above which the artifacts extracted from the managed PipelineRun results are
+	// truncated. A zero value uses the service default
+	MaxArtifactSize int
+
+	// MissingReleasePlanAdmissionGracePeriod is the time a Release waits for its ReleasePlanAdmission to be created
+	// before failing its validation. A zero value fails it immediately
+	MissingReleasePlanAdmissionGracePeriod time.Duration
+
+	// PipelinesAsCodeExcludedAnnotations are the keys of the Release annotations that are never propagated to the
+	// PipelineRuns, neither as Pipelines-as-Code annotations nor through the AnnotationPrefixes
+	PipelinesAsCodeExcludedAnnotations []string
+
+	// ProcessingDeadline is the maximum time a Release can be processed before its PipelineRun is cancelled. A zero
+	// value disables the deadline
+	ProcessingDeadline time.Duration
+
+	// SkipPipelineRunFinalizer prevents the Release finalizer from being added to the PipelineRuns, so they don't
+	// outlive their Release in environments where they are never cleaned up by the controller
+	SkipPipelineRunFinalizer bool
+
+	// WorkspaceName is the name of the workspace to bind
+	WorkspaceName string
+
+	// WorkspaceSize is the size of the volume to create for the workspace
+	WorkspaceSize string
+}
+
+// PipelineRunBuildError is the error returned by the Build method of a PipelineRunBuilder. It wraps all the errors
+// found by the builder methods, so callers can tell a PipelineRun that can't be built apart from other failures.
+type PipelineRunBuildError struct {
+	err error
+}
+
+// Error returns the message of the errors found while building the PipelineRun.
+func (e *PipelineRunBuildError) Error() string {
+	return e.err.Error()
+}
+
+// Unwrap returns the errors found while building the PipelineRun.
+func (e *PipelineRunBuildError) Unwrap() error {
+	return e.err
+}
+
+// FinalizerOptions contains the options to use when adding a finalizer to the PipelineRun.
+type FinalizerOptions struct {
+	// SkipFinalizer prevents the finalizer from being added
+	SkipFinalizer bool
+}
+
+// ObjectReference is a reference to a client.Object to be passed to the PipelineRun as a param. If Name is empty, the
+// param name is derived from the object's group and kind as <group>-<kind>.
+type ObjectReference struct {
+	// Name is the name of the param
+	Name string
+
+	// Object is the referenced object
+	Object client.Object
 }
 
 // NewPipelineRunBuilder initializes a new PipelineRunBuilder with the given name prefix and namespace.
@@ -51,13 +175,82 @@ func NewPipelineRunBuilder(namePrefix, namespace string) *PipelineRunBuilder {
 	}
 }
 
-// Build returns the constructed PipelineRun and any accumulated error.
+// Build returns the constructed PipelineRun and any accumulated error wrapped in a PipelineRunBuildError.
 func (b *PipelineRunBuilder) Build() (*tektonv1.PipelineRun, error) {
-	return b.pipelineRun, b.err.ErrorOrNil()
+	b.applyDefaults()
+
+	if err := b.err.ErrorOrNil(); err != nil {
+		return b.pipelineRun, &PipelineRunBuildError{err: err}
+	}
+
+	return b.pipelineRun, nil
+}
+
+// GetConfigMapValue returns the value of the given key in the ConfigMap with any leading and trailing whitespace
+// removed. If the key is missing or its value is empty, an error is returned.
+func GetConfigMapValue(configMap *corev1.ConfigMap, key string) (string, error) {
+	value := strings.TrimSpace(configMap.Data[key])
+	if value == "" {
+		return "", fmt.Errorf("the %s key is missing or empty in ConfigMap %s/%s", key, configMap.Namespace, configMap.Name)
+	}
+
+	return value, nil
+}
+
+// IsPipelineRunBuildError returns true if the given error was returned by the Build method of a PipelineRunBuilder.
+func IsPipelineRunBuildError(err error) bool {
+	var buildErr *PipelineRunBuildError
+	return errors.As(err, &buildErr)
+}
+
+// ParseObjectReference returns the Namespace and Name contained in a param value added by WithObjectReferences,
+// WithObjectReferenceNamed or WithObjectReferencesAsArray. An error is returned if the value doesn't contain exactly
+// one separator or if the name is empty. The namespace is empty for cluster scoped objects.
+func ParseObjectReference(value string) (namespace, name string, err error) {
+	namespace, name, found := strings.Cut(value, string(types.Separator))
+	if !found || name == "" || strings.ContainsRune(name, types.Separator) {
+		return "", "", fmt.Errorf("invalid object reference %q, expected the <namespace>%c<name> format", value,
+			types.Separator)
+	}
+
+	return namespace, name, nil
+}
+
+// RemoveFinalizer removes the given finalizer from the PipelineRun's metadata. Removing a finalizer that is not set is
+// a no-op.
+func (b *PipelineRunBuilder) RemoveFinalizer(finalizer string) *PipelineRunBuilder {
+	controllerutil.RemoveFinalizer(b.pipelineRun, finalizer)
+
+	return b
+}
+
+// Validate checks the PipelineRun being built for obvious mistakes. It verifies that either a PipelineRef referencing a
+// Pipeline by name or through a resolver or an embedded PipelineSpec is set and that a ServiceAccount is set. All the
+// problems found are returned in a single error.
+func (b *PipelineRunBuilder) Validate() error {
+	b.applyDefaults()
+
+	var result *multierror.Error
+
+	pipelineRef := b.pipelineRun.Spec.PipelineRef
+	if pipelineRef != nil && b.pipelineRun.Spec.PipelineSpec != nil {
+		result = multierror.Append(result, fmt.Errorf("the PipelineRef and the PipelineSpec can't be set at the same time"))
+	} else if pipelineRef == nil && b.pipelineRun.Spec.PipelineSpec == nil {
+		result = multierror.Append(result, fmt.Errorf("the PipelineRef is not set"))
+	} else if pipelineRef != nil && pipelineRef.Name == "" && pipelineRef.Resolver == "" {
+		result = multierror.Append(result, fmt.Errorf("the PipelineRef doesn't define a name or a resolver"))
+	}
+
+	if b.pipelineRun.Spec.TaskRunTemplate.ServiceAccountName == "" {
+		result = multierror.Append(result, fmt.Errorf("the ServiceAccount is not set"))
+	}
+
+	return result.ErrorOrNil()
 }
 
 // WithAnnotations appends or updates annotations to the PipelineRun's metadata.
-// If the PipelineRun does not have existing annotations, it initializes them before adding.
+// If the PipelineRun does not have existing annotations, it initializes them before adding. Annotations set by
+// other builder methods are preserved, and when the same key is set twice the value from the latest call wins.
 func (b *PipelineRunBuilder) WithAnnotations(annotations map[string]string) *PipelineRunBuilder {
 	if b.pipelineRun.ObjectMeta.Annotations == nil {
 		b.pipelineRun.ObjectMeta.Annotations = make(map[string]string)
@@ -70,6 +263,123 @@ func (b *PipelineRunBuilder) WithAnnotations(annotations map[string]string) *Pip
 	return b
 }
 
+// WithAnnotationsWithPrefixes copies the annotations of the given object matching any of the given prefixes to the
+// PipelineRun's metadata, following the same merge policy as WithAnnotations. Empty prefixes are ignored, so nothing is
+// copied if no prefix is given. Annotations whose key is in the exclude list are not copied.
+func (b *PipelineRunBuilder) WithAnnotationsWithPrefixes(object client.Object, prefixes []string, exclude ...string) *PipelineRunBuilder {
+	for _, prefix := range prefixes {
+		if prefix == "" {
+			continue
+		}
+		annotations := metadata.GetAnnotationsWithPrefix(object, prefix)
+		for _, key := range exclude {
+			delete(annotations, key)
+		}
+		b.WithAnnotations(annotations)
+	}
+
+	return b
+}
+
+// WithApplicationSnapshot adds a snapshot_spec parameter to the PipelineRun containing the JSON representation of the
+// given Snapshot's spec. If the serialized spec exceeds maxSnapshotSpecSize the parameter is not added and the reason
+// is recorded in the SnapshotSpecSkippedAnnotation, so Pipelines should fall back to the Snapshot reference in that case.
+func (b *PipelineRunBuilder) WithApplicationSnapshot(snapshot *applicationapiv1alpha1.Snapshot) *PipelineRunBuilder {
+	jsonData, err := json.Marshal(snapshot.Spec)
+	if err != nil {
+		b.err = multierror.Append(b.err, fmt.Errorf("failed to serialize snapshot spec to JSON: %v", err))
+		return b
+	}
+
+	if len(jsonData) > maxSnapshotSpecSize {
+		return b.WithAnnotations(map[string]string{
+			metadata.SnapshotSpecSkippedAnnotation: fmt.Sprintf("the snapshot spec size (%d bytes) exceeds the "+
+				"maximum param size (%d bytes)", len(jsonData), maxSnapshotSpecSize),
+		})
+	}
+
+	return b.WithParams(toTektonParam(Param{Name: "snapshot_spec", Value: string(jsonData)}))
+}
+
+// WithComponent adds the ComponentNameLabel to the PipelineRun's metadata so it can be filtered by component. The
+// label is merged with the existing ones and nothing is added if the component name is empty.
+func (b *PipelineRunBuilder) WithComponent(componentName string) *PipelineRunBuilder {
+	if componentName == "" {
+		return b
+	}
+
+	return b.WithLabels(map[string]string{
+		metadata.ComponentNameLabel: metadata.SanitizeLabelValue(componentName),
+	})
+}
+
+// WithConfigMapWorkspace creates and adds a read-only workspace backed by the given ConfigMap. If either the workspace
+// name or the ConfigMap name is empty, no workspace is added.
+func (b *PipelineRunBuilder) WithConfigMapWorkspace(name, configMapName string) *PipelineRunBuilder {
+	if name == "" || configMapName == "" {
+		return b
+	}
+
+	b.pipelineRun.Spec.Workspaces = append(b.pipelineRun.Spec.Workspaces, tektonv1.WorkspaceBinding{
+		Name: name,
+		ConfigMap: &corev1.ConfigMapVolumeSource{
+			LocalObjectReference: corev1.LocalObjectReference{
+				Name: configMapName,
+			},
+		},
+	})
+
+	return b
+}
+
+// WithData deep merges the given data objects using MergeData and adds the result as a data parameter to the
+// PipelineRun, so keys found in later objects take precedence. If there is no data to add, no parameter is added.
+func (b *PipelineRunBuilder) WithData(data ...*runtime.RawExtension) *PipelineRunBuilder {
+	merged, err := MergeData(data...)
+	if err != nil {
+		b.err = multierror.Append(b.err, err)
+		return b
+	}
+
+	if len(merged) == 0 {
+		return b
+	}
+
+	jsonData, err := json.Marshal(merged)
+	if err != nil {
+		b.err = multierror.Append(b.err, fmt.Errorf("failed to serialize data to JSON: %v", err))
+		return b
+	}
+
+	return b.WithParams(toTektonParam(Param{Name: "data", Value: string(jsonData)}))
+}
+
+// WithDefaults sets the defaults to use for the values the PipelineRun doesn't set. The defaults are applied when
+// the PipelineRun is built or validated, so they never override the values set by other methods, regardless of the
+// order in which they are called.
+func (b *PipelineRunBuilder) WithDefaults(config PipelineRunConfig) *PipelineRunBuilder {
+	b.defaults = &config
+	return b
+}
+
+// WithDeterministicName sets a predictable name for the PipelineRun in the form <name>-<attempt> instead of relying on
+// GenerateName. If the result would exceed the maximum label length, as PipelineRun names are used as label values by
+// Tekton, the given name is truncated and suffixed with a short hash of it, so names sharing a long prefix remain
+// distinct while the same name always produces the same result.
+func (b *PipelineRunBuilder) WithDeterministicName(name string, attempt int) *PipelineRunBuilder {
+	suffix := fmt.Sprintf("-%d", attempt)
+	if len(name)+len(suffix) > maxPipelineRunNameLength {
+		hash := sha256.Sum256([]byte(name))
+		name = strings.TrimRight(name[:maxPipelineRunNameLength-len(suffix)-deterministicNameHashLength-1], "-.") +
+			"-" + hex.EncodeToString(hash[:])[:deterministicNameHashLength]
+	}
+
+	b.pipelineRun.GenerateName = ""
+	b.pipelineRun.Name = name + suffix
+
+	return b
+}
+
 // WithEmptyDirVolume creates and adds a workspace backed by EmptyDir and using the provided
 // workspace name and volume size.
 func (b *PipelineRunBuilder) WithEmptyDirVolume(name, size string) *PipelineRunBuilder {
@@ -91,15 +401,45 @@ func (b *PipelineRunBuilder) WithEmptyDirVolume(name, size string) *PipelineRunB
 	return b
 }
 
-// WithFinalizer adds the given finalizer to the PipelineRun's metadata.
+// WithEmptyDirWorkspace creates and adds a workspace backed by an EmptyDir without a size limit, meant to be used as
+// scratch space. If the name is empty, no workspace is added.
+func (b *PipelineRunBuilder) WithEmptyDirWorkspace(name string) *PipelineRunBuilder {
+	if name == "" {
+		return b
+	}
+
+	b.pipelineRun.Spec.Workspaces = append(b.pipelineRun.Spec.Workspaces, tektonv1.WorkspaceBinding{
+		Name:     name,
+		EmptyDir: &corev1.EmptyDirVolumeSource{},
+	})
+
+	return b
+}
+
+// WithFinalizer adds the given finalizer to the PipelineRun's metadata. Adding a finalizer that is already set or an
+// empty finalizer is a no-op.
 func (b *PipelineRunBuilder) WithFinalizer(finalizer string) *PipelineRunBuilder {
+	if finalizer == "" {
+		return b
+	}
+
 	controllerutil.AddFinalizer(b.pipelineRun, finalizer)
 
 	return b
 }
 
+// WithFinalizerOptions adds the given finalizer to the PipelineRun's metadata unless the given options skip it.
+func (b *PipelineRunBuilder) WithFinalizerOptions(finalizer string, options FinalizerOptions) *PipelineRunBuilder {
+	if options.SkipFinalizer {
+		return b
+	}
+
+	return b.WithFinalizer(finalizer)
+}
+
 // WithLabels appends or updates labels to the PipelineRun's metadata.
-// If the PipelineRun does not have existing labels, it initializes them before adding.
+// If the PipelineRun does not have existing labels, it initializes them before adding. Labels set by
+// other builder methods are preserved, and when the same key is set twice the value from the latest call wins.
 func (b *PipelineRunBuilder) WithLabels(labels map[string]string) *PipelineRunBuilder {
 	if b.pipelineRun.ObjectMeta.Labels == nil {
 		b.pipelineRun.ObjectMeta.Labels = make(map[string]string)
@@ -112,34 +452,105 @@ func (b *PipelineRunBuilder) WithLabels(labels map[string]string) *PipelineRunBu
 	return b
 }
 
+// WithNodeSelector merges the given node selector into the PodTemplate of the PipelineRun's TaskRunTemplate,
+// initializing it if needed. If the node selector is empty, the PodTemplate is left untouched.
+func (b *PipelineRunBuilder) WithNodeSelector(nodeSelector map[string]string) *PipelineRunBuilder {
+	if len(nodeSelector) == 0 {
+		return b
+	}
+
+	podTemplate := b.getPodTemplate()
+	if podTemplate.NodeSelector == nil {
+		podTemplate.NodeSelector = make(map[string]string, len(nodeSelector))
+	}
+	for key, value := range nodeSelector {
+		podTemplate.NodeSelector[key] = value
+	}
+
+	return b
+}
+
+// WithObjectParam adds an object param with the given name and value to the PipelineRun, for Pipelines declaring
+// params of the object type. A nil value is added as an empty object.
+func (b *PipelineRunBuilder) WithObjectParam(name string, value map[string]string) *PipelineRunBuilder {
+	if value == nil {
+		value = map[string]string{}
+	}
+
+	return b.WithParams(tektonv1.Param{
+		Name: name,
+		Value: tektonv1.ParamValue{
+			Type:      tektonv1.ParamTypeObject,
+			ObjectVal: value,
+		},
+	})
+}
+
+// WithObjectReferenceNamed adds a param with the given name whose value is a combination of the object's Namespace and
+// Name. It allows passing several objects of the same kind, which would collide with WithObjectReferences. If the
+// name is empty or already used by another object reference, an error is accumulated in the builder's err field using
+// multierror.
+func (b *PipelineRunBuilder) WithObjectReferenceNamed(paramName string, object client.Object) *PipelineRunBuilder {
+	b.addObjectReference(paramName, object)
+
+	return b
+}
+
 // WithObjectReferences constructs tektonv1.Param entries for each of the provided client.Objects.
 // Each param name is derived from the object's Kind (with the first letter made lowercase) and
-// the value is a combination of the object's Namespace and Name.
+// the value is a combination of the object's Namespace and Name. If two references end up using the same param
+// name, an error is accumulated in the builder's err field using multierror.
 func (b *PipelineRunBuilder) WithObjectReferences(objects ...client.Object) *PipelineRunBuilder {
 	for _, obj := range objects {
 		name := []rune(obj.GetObjectKind().GroupVersionKind().Kind)
-		name[0] = unicode.ToLower(name[0])
+		if len(name) > 0 {
+			name[0] = unicode.ToLower(name[0])
+		}
 
-		b.WithParams(tektonv1.Param{
-			Name: string(name),
-			Value: tektonv1.ParamValue{
-				Type:      tektonv1.ParamTypeString,
-				StringVal: obj.GetNamespace() + "/" + obj.GetName(),
-			},
-		})
+		b.addObjectReference(string(name), obj)
 	}
 
 	return b
 }
 
+// WithObjectReferencesAsArray adds an array parameter with the given name to the PipelineRun. The values of the array
+// are the combination of the Namespace and Name of each of the provided client.Objects, in the same order. If no
+// objects are provided, the parameter is added with an empty array.
+func (b *PipelineRunBuilder) WithObjectReferencesAsArray(paramName string, objects ...client.Object) *PipelineRunBuilder {
+	references := make([]string, 0, len(objects))
+	for _, obj := range objects {
+		reference, err := newObjectReference(obj)
+		if err != nil {
+			b.err = multierror.Append(b.err, err)
+			continue
+		}
+		references = append(references, reference)
+	}
+
+	return b.WithParams(tektonv1.Param{
+		Name: paramName,
+		Value: tektonv1.ParamValue{
+			Type:     tektonv1.ParamTypeArray,
+			ArrayVal: references,
+		},
+	})
+}
+
 // WithObjectSpecsAsJson constructs tektonv1.Param entries for the Spec field of each of the provided client.Objects.
-// Each param name is derived from the object's Kind (with the first letter made lowercase).
+// Each param name is derived from the object's Kind (with the first letter made lowercase). As typed clients don't
+// always populate the TypeMeta, the name of the object's Go type is used when the Kind is empty.
 // The value for each param is the JSON representation of the object's Spec.
 // If an error occurs during extraction or serialization, it's accumulated in the builder's err field using multierror.
 func (b *PipelineRunBuilder) WithObjectSpecsAsJson(objects ...client.Object) *PipelineRunBuilder {
 	for _, obj := range objects {
-		name := []rune(obj.GetObjectKind().GroupVersionKind().Kind)
-		name[0] = unicode.ToLower(name[0])
+		kind := obj.GetObjectKind().GroupVersionKind().Kind
+		if kind == "" {
+			kind = reflect.TypeOf(obj).Elem().Name()
+		}
+		name := []rune(kind)
+		if len(name) > 0 {
+			name[0] = unicode.ToLower(name[0])
+		}
 
 		value := reflect.ValueOf(obj).Elem().FieldByName("Spec")
 		if !value.IsValid() {
@@ -165,8 +576,8 @@ func (b *PipelineRunBuilder) WithObjectSpecsAsJson(objects ...client.Object) *Pi
 	return b
 }
 
-// WithOwner sets the given client.Object as the owner of the PipelineRun.
-// It also adds the ReleaseFinalizer to the PipelineRun.
+// WithOwner sets the given client.Object as the owner of the PipelineRun. It doesn't add any finalizer, so
+// WithFinalizer has to be called as well when the PipelineRun should be protected from deletion.
 func (b *PipelineRunBuilder) WithOwner(object client.Object) *PipelineRunBuilder {
 	if err := libhandler.SetOwnerAnnotations(object, b.pipelineRun); err != nil {
 		b.err = multierror.Append(b.err, fmt.Errorf("failed to set owner annotations: %v", err))
@@ -176,13 +587,62 @@ func (b *PipelineRunBuilder) WithOwner(object client.Object) *PipelineRunBuilder
 	return b
 }
 
-// WithParams appends the provided params to the PipelineRun's spec.
+// WithOwnerReference sets the given client.Object as the controller owner of the PipelineRun when both live in the
+// same namespace, so the PipelineRun is garbage collected with its owner. Cross-namespace owner references are not
+// allowed, so in that case the PipelineRun is left untouched and WithOwner annotations should be used instead.
+func (b *PipelineRunBuilder) WithOwnerReference(object client.Object, scheme *runtime.Scheme) *PipelineRunBuilder {
+	if object.GetNamespace() != b.pipelineRun.Namespace {
+		return b
+	}
+
+	if err := controllerutil.SetControllerReference(object, b.pipelineRun, scheme); err != nil {
+		b.err = multierror.Append(b.err, fmt.Errorf("failed to set owner reference: %v", err))
+	}
+
+	return b
+}
+
+// WithParamIfNotEmpty adds a string param with the given name and value to the PipelineRun. If the value is empty,
+// no param is added.
+func (b *PipelineRunBuilder) WithParamIfNotEmpty(name, value string) *PipelineRunBuilder {
+	if value == "" {
+		return b
+	}
+
+	return b.WithParams(tektonv1.Param{
+		Name: name,
+		Value: tektonv1.ParamValue{
+			Type:      tektonv1.ParamTypeString,
+			StringVal: value,
+		},
+	})
+}
+
+// WithParams appends the provided params to the PipelineRun's spec. If a param with the same name already exists, its
+// value is replaced instead, so params added later take precedence over the ones added before them.
 func (b *PipelineRunBuilder) WithParams(params ...tektonv1.Param) *PipelineRunBuilder {
 	if b.pipelineRun.Spec.Params == nil {
 		b.pipelineRun.Spec.Params = make([]tektonv1.Param, 0)
 	}
 
-	b.pipelineRun.Spec.Params = append(b.pipelineRun.Spec.Params, params...)
+	for _, param := range params {
+		// Params are deep copied so later changes to the array or object values of the caller don't leak into the
+		// PipelineRun
+		param = *param.DeepCopy()
+
+		replaced := false
+		for i := range b.pipelineRun.Spec.Params {
+			if b.pipelineRun.Spec.Params[i].Name == param.Name {
+				b.pipelineRun.Spec.Params[i] = param
+				replaced = true
+				break
+			}
+		}
+
+		if !replaced {
+			b.pipelineRun.Spec.Params = append(b.pipelineRun.Spec.Params, param)
+		}
+	}
 
 	return b
 }
@@ -190,7 +650,7 @@ func (b *PipelineRunBuilder) WithParams(params ...tektonv1.Param) *PipelineRunBu
 // WithParamsFromConfigMap adds parameters to the PipelineRun based on the provided keys from a given ConfigMap.
 // If a key is present in the ConfigMap, a new tektonv1.Param is constructed with the key as the name and the associated
 // value from the ConfigMap. Keys not found in the ConfigMap are ignored.
-func (b *PipelineRunBuilder) WithParamsFromConfigMap(configMap *corev1.ConfigMap, keys []string) *PipelineRunBuilder {
+func (b *PipelineRunBuilder) WithParamsFromConfigMap(configMap *corev1.ConfigMap, keys ...string) *PipelineRunBuilder {
 	if configMap == nil {
 		return b
 	}
@@ -198,19 +658,34 @@ func (b *PipelineRunBuilder) WithParamsFromConfigMap(configMap *corev1.ConfigMap
 	var params []tektonv1.Param
 	for _, key := range keys {
 		if value, exists := configMap.Data[key]; exists {
-			params = append(params, tektonv1.Param{
-				Name: key,
-				Value: tektonv1.ParamValue{
-					Type:      tektonv1.ParamTypeString,
-					StringVal: value,
-				},
-			})
+			params = append(params, toTektonParam(Param{Name: key, Value: value}))
 		}
 	}
 
 	return b.WithParams(params...)
 }
 
+// WithPinnedBundle sets a PipelineRef using the bundles resolver to reference the Pipeline with the given name in the
+// given bundle. For the release to be reproducible, the bundle must be pinned by digest, so an error is accumulated in
+// the builder's err field using multierror if it only has a tag or is malformed.
+func (b *PipelineRunBuilder) WithPinnedBundle(bundle, name string) *PipelineRunBuilder {
+	if !digestPinnedReference.MatchString(bundle) {
+		b.err = multierror.Append(b.err, fmt.Errorf("the bundle %q is not pinned by a sha256 digest", bundle))
+		return b
+	}
+
+	return b.WithPipelineRef(&tektonv1.PipelineRef{
+		ResolverRef: tektonv1.ResolverRef{
+			Resolver: "bundles",
+			Params: tektonv1.Params{
+				{Name: "bundle", Value: *tektonv1.NewStructuredValues(bundle)},
+				{Name: "kind", Value: *tektonv1.NewStructuredValues("pipeline")},
+				{Name: "name", Value: *tektonv1.NewStructuredValues(name)},
+			},
+		},
+	})
+}
+
 // WithPipelineRef sets the PipelineRef for the PipelineRun's spec.
 func (b *PipelineRunBuilder) WithPipelineRef(pipelineRef *tektonv1.PipelineRef) *PipelineRunBuilder {
 	b.pipelineRun.Spec.PipelineRef = pipelineRef
@@ -242,58 +717,583 @@ func (b *PipelineRunBuilder) WithPipelineRef(pipelineRef *tektonv1.PipelineRef)
 	return b
 }
 
-// WithServiceAccount sets the ServiceAccountName for the PipelineRun's TaskRunTemplate.
+// WithPipelineSpec embeds a copy of the given PipelineSpec in the PipelineRun. An embedded PipelineSpec is required to
+// configure Pipeline level settings of the tasks, like matrices, from the PipelineRun.
+func (b *PipelineRunBuilder) WithPipelineSpec(pipelineSpec *tektonv1.PipelineSpec) *PipelineRunBuilder {
+	b.pipelineRun.Spec.PipelineSpec = pipelineSpec.DeepCopy()
+
+	return b
+}
+
+// WithPipelinesAsCodeAnnotations copies the Pipelines-as-Code annotations of the given object to the PipelineRun's
+// metadata, following the same merge policy as WithAnnotations. Annotations whose key is in the exclude list are not
+// copied.
+func (b *PipelineRunBuilder) WithPipelinesAsCodeAnnotations(object client.Object, exclude ...string) *PipelineRunBuilder {
+	annotations := metadata.GetAnnotationsWithPrefix(object, metadata.PipelinesAsCodePrefix)
+	for _, key := range exclude {
+		delete(annotations, key)
+	}
+
+	return b.WithAnnotations(annotations)
+}
+
+// WithPodTemplate adds the given node selector and tolerations to the PodTemplate of the PipelineRun's
+// TaskRunTemplate. If both are empty, the PodTemplate is left unset.
+func (b *PipelineRunBuilder) WithPodTemplate(nodeSelector map[string]string, tolerations []corev1.Toleration) *PipelineRunBuilder {
+	return b.WithNodeSelector(nodeSelector).WithTolerations(tolerations)
+}
+
+// WithPriorityClass sets the given PriorityClass name in the PodTemplate of the PipelineRun's TaskRunTemplate,
+// initializing it if needed. If the name is empty, the PodTemplate is left untouched.
+func (b *PipelineRunBuilder) WithPriorityClass(name string) *PipelineRunBuilder {
+	return b.WithSchedulingOptions(name, "")
+}
+
+// WithRegistryAuthParam adds a string param named after RegistryAuthSecretParamName pointing to the Secret holding the
+// registry credentials. If the Secret name is empty, no param is added.
+func (b *PipelineRunBuilder) WithRegistryAuthParam(secretName string) *PipelineRunBuilder {
+	return b.WithParamIfNotEmpty(RegistryAuthSecretParamName, secretName)
+}
+
+// WithReleaseUID adds a string param named after ReleaseUIDParamName containing the UID of the given Release, so tasks
+// can tell apart Releases that reuse the same name. If the Release is nil, even if it's a typed nil pointer, or has no
+// UID, no param is added.
+func (b *PipelineRunBuilder) WithReleaseUID(release client.Object) *PipelineRunBuilder {
+	if release == nil || reflect.ValueOf(release).IsNil() {
+		return b
+	}
+
+	return b.WithParamIfNotEmpty(ReleaseUIDParamName, string(release.GetUID()))
+}
+
+// WithRequiredParamsFromConfigMap adds a parameter to the PipelineRun for each of the provided keys in the given
+// ConfigMap, using the key as the name. Unlike WithParamsFromConfigMap, an error is accumulated for each key that is
+// missing or empty. If the ConfigMap is nil, no parameters are added.
+func (b *PipelineRunBuilder) WithRequiredParamsFromConfigMap(configMap *corev1.ConfigMap, keys ...string) *PipelineRunBuilder {
+	if configMap == nil {
+		return b
+	}
+
+	for _, key := range keys {
+		value, err := GetConfigMapValue(configMap, key)
+		if err != nil {
+			b.err = multierror.Append(b.err, err)
+			continue
+		}
+
+		b.WithParams(toTektonParam(Param{Name: key, Value: value}))
+	}
+
+	return b
+}
+
+// WithSchedulingOptions sets the given PriorityClass and scheduler names in the PodTemplate of the PipelineRun's
+// TaskRunTemplate. Empty values are ignored, so if both are empty the PodTemplate is left untouched.
+func (b *PipelineRunBuilder) WithSchedulingOptions(priorityClassName, schedulerName string) *PipelineRunBuilder {
+	if priorityClassName != "" {
+		b.getPodTemplate().PriorityClassName = &priorityClassName
+	}
+
+	if schedulerName != "" {
+		b.getPodTemplate().SchedulerName = schedulerName
+	}
+
+	return b
+}
+
+// WithSecretWorkspace creates and adds a workspace backed by the given Secret. If either the workspace name or the
+// Secret name is empty, no workspace is added.
+func (b *PipelineRunBuilder) WithSecretWorkspace(name, secretName string) *PipelineRunBuilder {
+	if name == "" || secretName == "" {
+		return b
+	}
+
+	b.pipelineRun.Spec.Workspaces = append(b.pipelineRun.Spec.Workspaces, tektonv1.WorkspaceBinding{
+		Name: name,
+		Secret: &corev1.SecretVolumeSource{
+			SecretName: secretName,
+		},
+	})
+
+	return b
+}
+
+// WithServiceAccount sets the ServiceAccountName for the PipelineRun's TaskRunTemplate. If the ServiceAccount is empty,
+// the ServiceAccountName is left untouched so it can be set by the defaults instead of by Tekton.
 func (b *PipelineRunBuilder) WithServiceAccount(serviceAccount string) *PipelineRunBuilder {
+	if serviceAccount == "" {
+		return b
+	}
+
 	b.pipelineRun.Spec.TaskRunTemplate.ServiceAccountName = serviceAccount
 
 	return b
 }
 
-// WithTaskRunSpecs sets the provided TaskRunSpecs to the PipelineRun's spec.
+// WithServiceAccountOrDefault sets the ServiceAccountName for the PipelineRun's TaskRunTemplate, falling back to the
+// given default ServiceAccount if the provided one is empty. If both are empty, an error is accumulated in the
+// builder's err field using multierror, as the PipelineRun would otherwise run with the namespace's default
+// ServiceAccount.
+func (b *PipelineRunBuilder) WithServiceAccountOrDefault(serviceAccount, defaultServiceAccount string) *PipelineRunBuilder {
+	if serviceAccount == "" {
+		serviceAccount = defaultServiceAccount
+	}
+	if serviceAccount == "" {
+		b.err = multierror.Append(b.err, fmt.Errorf("no ServiceAccount or default ServiceAccount provided"))
+		return b
+	}
+
+	return b.WithServiceAccount(serviceAccount)
+}
+
+// WithSigningParams adds string params named after CosignKeySecretParamName and RekorURLParamName containing the
+// configuration used to sign artifacts. Params with an empty value are not added.
+func (b *PipelineRunBuilder) WithSigningParams(keySecret, rekorURL string) *PipelineRunBuilder {
+	return b.WithParamIfNotEmpty(CosignKeySecretParamName, keySecret).
+		WithParamIfNotEmpty(RekorURLParamName, rekorURL)
+}
+
+// WithTaskComputeResources sets the given resource requests and limits for the task with the given name. The
+// resources are set per task rather than for the whole PipelineRun, as Tekton can only apply TaskRunSpecs to named
+// tasks. If the task already has a TaskRunSpec, its ComputeResources are replaced; otherwise, a new TaskRunSpec is
+// added. If the task name is empty, an error is accumulated in the builder's err field using multierror.
+func (b *PipelineRunBuilder) WithTaskComputeResources(taskName string, requests, limits corev1.ResourceList) *PipelineRunBuilder {
+	if taskName == "" {
+		b.err = multierror.Append(b.err, fmt.Errorf("a task name is required to set compute resources"))
+		return b
+	}
+
+	computeResources := &corev1.ResourceRequirements{
+		Requests: requests,
+		Limits:   limits,
+	}
+
+	for i := range b.pipelineRun.Spec.TaskRunSpecs {
+		if b.pipelineRun.Spec.TaskRunSpecs[i].PipelineTaskName == taskName {
+			b.pipelineRun.Spec.TaskRunSpecs[i].ComputeResources = computeResources
+			return b
+		}
+	}
+
+	b.pipelineRun.Spec.TaskRunSpecs = append(b.pipelineRun.Spec.TaskRunSpecs, tektonv1.PipelineTaskRunSpec{
+		PipelineTaskName: taskName,
+		ComputeResources: computeResources,
+	})
+
+	return b
+}
+
+// WithTaskEnvVar injects the given environment variable into the steps of the named task through the pod template of
+// its TaskRunSpec. Repeated calls for the same task accumulate the variables, and setting a variable that is already
+// defined for the task replaces its value. If the task name or the variable name is empty, an error is accumulated in
+// the builder's err field using multierror.
+func (b *PipelineRunBuilder) WithTaskEnvVar(taskName, name, value string) *PipelineRunBuilder {
+	if taskName == "" || name == "" {
+		b.err = multierror.Append(b.err, fmt.Errorf("a task name and a variable name are required to set an env var"))
+		return b
+	}
+
+	var taskRunSpec *tektonv1.PipelineTaskRunSpec
+	for i := range b.pipelineRun.Spec.TaskRunSpecs {
+		if b.pipelineRun.Spec.TaskRunSpecs[i].PipelineTaskName == taskName {
+			taskRunSpec = &b.pipelineRun.Spec.TaskRunSpecs[i]
+			break
+		}
+	}
+	if taskRunSpec == nil {
+		b.pipelineRun.Spec.TaskRunSpecs = append(b.pipelineRun.Spec.TaskRunSpecs, tektonv1.PipelineTaskRunSpec{
+			PipelineTaskName: taskName,
+		})
+		taskRunSpec = &b.pipelineRun.Spec.TaskRunSpecs[len(b.pipelineRun.Spec.TaskRunSpecs)-1]
+	}
+
+	if taskRunSpec.PodTemplate == nil {
+		taskRunSpec.PodTemplate = &pod.PodTemplate{}
+	}
+
+	for i := range taskRunSpec.PodTemplate.Env {
+		if taskRunSpec.PodTemplate.Env[i].Name == name {
+			taskRunSpec.PodTemplate.Env[i].Value = value
+			return b
+		}
+	}
+	taskRunSpec.PodTemplate.Env = append(taskRunSpec.PodTemplate.Env, corev1.EnvVar{Name: name, Value: value})
+
+	return b
+}
+
+// WithTaskMatrix fans out the named task of the embedded PipelineSpec using the given array params as its matrix. Tekton
+// only supports matrices at the Pipeline level, so an error is accumulated in the builder's err field using multierror
+// if no PipelineSpec is embedded, the task is not part of it or any of the params is not an array.
+func (b *PipelineRunBuilder) WithTaskMatrix(taskName string, matrixParams ...tektonv1.Param) *PipelineRunBuilder {
+	pipelineSpec := b.pipelineRun.Spec.PipelineSpec
+	if pipelineSpec == nil {
+		b.err = multierror.Append(b.err, fmt.Errorf("a PipelineSpec must be embedded to set a matrix on task %s", taskName))
+		return b
+	}
+
+	var pipelineTask *tektonv1.PipelineTask
+	for _, tasks := range [][]tektonv1.PipelineTask{pipelineSpec.Tasks, pipelineSpec.Finally} {
+		for i := range tasks {
+			if tasks[i].Name == taskName {
+				pipelineTask = &tasks[i]
+				break
+			}
+		}
+	}
+	if pipelineTask == nil {
+		b.err = multierror.Append(b.err, fmt.Errorf("task %s is not defined in the PipelineSpec", taskName))
+		return b
+	}
+
+	matrix := &tektonv1.Matrix{}
+	for _, param := range matrixParams {
+		if param.Value.Type != tektonv1.ParamTypeArray {
+			b.err = multierror.Append(b.err, fmt.Errorf("matrix param %s of task %s is not an array", param.Name, taskName))
+			return b
+		}
+		matrix.Params = append(matrix.Params, *param.DeepCopy())
+	}
+	pipelineTask.Matrix = matrix
+
+	return b
+}
+
+// WithTaskRunSpecs sets the provided TaskRunSpecs to the PipelineRun's spec. Specs without a PipelineTaskName are
+// skipped, as Tekton can't match them to any task in the Pipeline. Any TaskRunSpec set before is replaced, so the
+// per-task methods like WithTaskComputeResources have to be called afterwards for their values to take precedence.
 func (b *PipelineRunBuilder) WithTaskRunSpecs(taskRunSpecs ...tektonv1.PipelineTaskRunSpec) *PipelineRunBuilder {
-	b.pipelineRun.Spec.TaskRunSpecs = taskRunSpecs
+	var specs []tektonv1.PipelineTaskRunSpec
+	for _, taskRunSpec := range taskRunSpecs {
+		if taskRunSpec.PipelineTaskName == "" {
+			continue
+		}
+		specs = append(specs, taskRunSpec)
+	}
+
+	b.pipelineRun.Spec.TaskRunSpecs = specs
+	return b
+}
+
+// WithTaskServiceAccount sets the ServiceAccount to run the task with the given name as, overriding the one set for
+// the whole PipelineRun only for that task. If the task already has a TaskRunSpec, its ServiceAccountName is replaced;
+// otherwise, a new TaskRunSpec is added. If the task name or the ServiceAccount is empty, an error is accumulated in the
+// builder's err field using multierror.
+func (b *PipelineRunBuilder) WithTaskServiceAccount(taskName, serviceAccount string) *PipelineRunBuilder {
+	if taskName == "" || serviceAccount == "" {
+		b.err = multierror.Append(b.err, fmt.Errorf("a task name and a service account are required to set a task service account"))
+		return b
+	}
+
+	for i := range b.pipelineRun.Spec.TaskRunSpecs {
+		if b.pipelineRun.Spec.TaskRunSpecs[i].PipelineTaskName == taskName {
+			b.pipelineRun.Spec.TaskRunSpecs[i].ServiceAccountName = serviceAccount
+			return b
+		}
+	}
+
+	b.pipelineRun.Spec.TaskRunSpecs = append(b.pipelineRun.Spec.TaskRunSpecs, tektonv1.PipelineTaskRunSpec{
+		PipelineTaskName:   taskName,
+		ServiceAccountName: serviceAccount,
+	})
+
+	return b
+}
+
+// WithTimeoutDurations sets the Timeouts for the PipelineRun using the given durations. Zero durations are omitted
+// instead of being set, as Tekton interprets a zero timeout as no timeout at all.
+func (b *PipelineRunBuilder) WithTimeoutDurations(pipeline, tasks, finally time.Duration) *PipelineRunBuilder {
+	timeouts := tektonv1.TimeoutFields{}
+
+	if pipeline != 0 {
+		timeouts.Pipeline = &metav1.Duration{Duration: pipeline}
+	}
+
+	if tasks != 0 {
+		timeouts.Tasks = &metav1.Duration{Duration: tasks}
+	}
+
+	if finally != 0 {
+		timeouts.Finally = &metav1.Duration{Duration: finally}
+	}
+
+	if timeouts != (tektonv1.TimeoutFields{}) {
+		b.validateTimeouts(&timeouts)
+		b.pipelineRun.Spec.Timeouts = &timeouts
+	}
+
 	return b
 }
 
-// WithTimeouts sets the Timeouts for the PipelineRun.
+// WithTimeouts sets the Timeouts for the PipelineRun. An error is accumulated if the combined tasks and finally
+// timeouts exceed the pipeline timeout.
 func (b *PipelineRunBuilder) WithTimeouts(timeouts, defaultTimeouts *tektonv1.TimeoutFields) *PipelineRunBuilder {
 	if timeouts == nil || *timeouts == (tektonv1.TimeoutFields{}) {
 		b.pipelineRun.Spec.Timeouts = defaultTimeouts
 	} else {
 		b.pipelineRun.Spec.Timeouts = timeouts
 	}
+	b.validateTimeouts(b.pipelineRun.Spec.Timeouts)
 
 	return b
 }
 
-// WithWorkspaceFromVolumeTemplate creates and adds a workspace binding to the PipelineRun's spec using
-// the provided workspace name and volume size.
-func (b *PipelineRunBuilder) WithWorkspaceFromVolumeTemplate(name, size string) *PipelineRunBuilder {
-	if b.pipelineRun.Spec.Workspaces == nil {
-		b.pipelineRun.Spec.Workspaces = []tektonv1.WorkspaceBinding{}
+// WithTolerations appends the given tolerations to the PodTemplate of the PipelineRun's TaskRunTemplate,
+// initializing it if needed. If no tolerations are passed, the PodTemplate is left untouched.
+func (b *PipelineRunBuilder) WithTolerations(tolerations []corev1.Toleration) *PipelineRunBuilder {
+	if len(tolerations) == 0 {
+		return b
+	}
+
+	podTemplate := b.getPodTemplate()
+	podTemplate.Tolerations = append(podTemplate.Tolerations, tolerations...)
+
+	return b
+}
+
+// WithTraceContext adds the W3C trace context of the span active in the given context to the PipelineRun's annotations,
+// using the name of each trace context header prefixed by metadata.TraceContextAnnotationPrefix as key, so the
+// PipelineRun can be correlated with the reconcile that created it. If no span is active, no annotations are added.
+func (b *PipelineRunBuilder) WithTraceContext(ctx context.Context) *PipelineRunBuilder {
+	if !trace.SpanContextFromContext(ctx).IsValid() {
+		return b
+	}
+
+	carrier := propagation.MapCarrier{}
+	propagation.TraceContext{}.Inject(ctx, carrier)
+
+	annotations := make(map[string]string, len(carrier))
+	for key, value := range carrier {
+		annotations[metadata.TraceContextAnnotationPrefix+key] = value
+	}
+
+	return b.WithAnnotations(annotations)
+}
+
+// WithTypedObjectReferences constructs tektonv1.Param entries for each of the provided ObjectReferences. Each param
+// is named after the reference's Name or, if empty, after the object's group and kind as <group>-<kind> (just <kind>
+// for the core group), all in lowercase. The value is a combination of the object's Namespace and Name. If two
+// references end up using the same param name, an error is accumulated in the builder's err field using multierror.
+func (b *PipelineRunBuilder) WithTypedObjectReferences(references ...ObjectReference) *PipelineRunBuilder {
+	for _, reference := range references {
+		name := reference.Name
+		if name == "" {
+			gvk := reference.Object.GetObjectKind().GroupVersionKind()
+			name = strings.ToLower(gvk.Kind)
+			if gvk.Group != "" && gvk.Kind != "" {
+				name = strings.ToLower(gvk.Group + "-" + gvk.Kind)
+			}
+		}
+
+		b.addObjectReference(name, reference.Object)
 	}
 
+	return b
+}
+
+// WithWorkspaceFromPersistentVolumeClaim adds a workspace binding to the PipelineRun's spec using the provided
+// workspace name and existing PersistentVolumeClaim. The subPath is sanitized so it contains a single directory made of
+// valid path characters, allowing PipelineRuns sharing the same claim to work in their own directory. If the name is
+// empty, no workspace is added.
+func (b *PipelineRunBuilder) WithWorkspaceFromPersistentVolumeClaim(name, claimName, subPath string) *PipelineRunBuilder {
+	if name == "" {
+		return b
+	}
+
+	b.pipelineRun.Spec.Workspaces = append(b.pipelineRun.Spec.Workspaces, tektonv1.WorkspaceBinding{
+		Name: name,
+		PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{
+			ClaimName: claimName,
+		},
+		SubPath: strings.Trim(invalidSubPathCharacters.ReplaceAllString(subPath, "-"), ".-"),
+	})
+
+	return b
+}
+
+// WithWorkspaceFromVolumeClaimTemplate adds a workspace binding to the PipelineRun's spec using the provided workspace
+// name and PersistentVolumeClaim template. If the name is empty, no workspace is added.
+func (b *PipelineRunBuilder) WithWorkspaceFromVolumeClaimTemplate(name string, template *corev1.PersistentVolumeClaim) *PipelineRunBuilder {
+	if name == "" {
+		return b
+	}
+
+	b.pipelineRun.Spec.Workspaces = append(b.pipelineRun.Spec.Workspaces, tektonv1.WorkspaceBinding{
+		Name:                name,
+		VolumeClaimTemplate: template,
+	})
+
+	return b
+}
+
+// WithWorkspaceFromVolumeTemplate creates and adds a workspace binding to the PipelineRun's spec using
+// the provided workspace name, volume size and storage class. If the storage class is empty, the cluster's default
+// storage class will be used.
+func (b *PipelineRunBuilder) WithWorkspaceFromVolumeTemplate(name, size, storageClass string) *PipelineRunBuilder {
 	quantity, err := resource.ParseQuantity(size)
 	if err != nil {
 		b.err = multierror.Append(b.err, fmt.Errorf("invalid size format: %v", err))
 		return b
 	}
 
-	workspace := tektonv1.WorkspaceBinding{
-		Name: name,
-		VolumeClaimTemplate: &corev1.PersistentVolumeClaim{
-			Spec: corev1.PersistentVolumeClaimSpec{
-				AccessModes: []corev1.PersistentVolumeAccessMode{corev1.ReadWriteOnce},
-				Resources: corev1.VolumeResourceRequirements{
-					Requests: corev1.ResourceList{
-						corev1.ResourceStorage: quantity,
-					},
+	template := &corev1.PersistentVolumeClaim{
+		Spec: corev1.PersistentVolumeClaimSpec{
+			AccessModes: []corev1.PersistentVolumeAccessMode{corev1.ReadWriteOnce},
+			Resources: corev1.VolumeResourceRequirements{
+				Requests: corev1.ResourceList{
+					corev1.ResourceStorage: quantity,
 				},
 			},
 		},
 	}
+	if storageClass != "" {
+		template.Spec.StorageClassName = &storageClass
+	}
 
-	b.pipelineRun.Spec.Workspaces = append(b.pipelineRun.Spec.Workspaces, workspace)
+	return b.WithWorkspaceFromVolumeClaimTemplate(name, template)
+}
+
+// WithWorkspaces adds a workspace binding for each of the given Workspaces, dispatching to the builder method matching
+// the source set in each of them. An error is accumulated in the builder's err field using multierror for every
+// Workspace whose name is already bound in the PipelineRun or that doesn't set exactly one source.
+func (b *PipelineRunBuilder) WithWorkspaces(workspaces ...Workspace) *PipelineRunBuilder {
+	for _, workspace := range workspaces {
+		bound := false
+		for _, binding := range b.pipelineRun.Spec.Workspaces {
+			if binding.Name == workspace.Name {
+				bound = true
+				break
+			}
+		}
+		if bound {
+			b.err = multierror.Append(b.err, fmt.Errorf("a workspace named %s is already bound", workspace.Name))
+			continue
+		}
+
+		sources := 0
+		for _, isSet := range []bool{workspace.ConfigMap != "", workspace.EmptyDir,
+			workspace.PersistentVolumeClaim != "", workspace.Secret != ""} {
+			if isSet {
+				sources++
+			}
+		}
+		if sources != 1 {
+			b.err = multierror.Append(b.err, fmt.Errorf("the %s workspace must set exactly one source", workspace.Name))
+			continue
+		}
+
+		switch {
+		case workspace.ConfigMap != "":
+			b.WithConfigMapWorkspace(workspace.Name, workspace.ConfigMap)
+		case workspace.EmptyDir:
+			b.WithEmptyDirWorkspace(workspace.Name)
+		case workspace.PersistentVolumeClaim != "":
+			b.WithWorkspaceFromPersistentVolumeClaim(workspace.Name, workspace.PersistentVolumeClaim, "")
+		case workspace.Secret != "":
+			b.WithSecretWorkspace(workspace.Name, workspace.Secret)
+		}
+	}
 
 	return b
 }
+
+// addObjectReference adds a string param with the given name containing the Namespace and Name of the given object.
+// An error is accumulated if the name is empty, has already been used by another object reference or if the object
+// can't be referenced unambiguously.
+func (b *PipelineRunBuilder) addObjectReference(name string, object client.Object) {
+	if name == "" {
+		b.err = multierror.Append(b.err, fmt.Errorf("unable to determine the param name for the reference to %s/%s",
+			object.GetNamespace(), object.GetName()))
+		return
+	}
+
+	if b.objectReferences[name] {
+		b.err = multierror.Append(b.err, fmt.Errorf("the %s param is already used by another object reference", name))
+		return
+	}
+
+	reference, err := newObjectReference(object)
+	if err != nil {
+		b.err = multierror.Append(b.err, err)
+		return
+	}
+
+	if b.objectReferences == nil {
+		b.objectReferences = map[string]bool{}
+	}
+	b.objectReferences[name] = true
+
+	b.WithParams(tektonv1.Param{
+		Name: name,
+		Value: tektonv1.ParamValue{
+			Type:      tektonv1.ParamTypeString,
+			StringVal: reference,
+		},
+	})
+}
+
+// applyDefaults sets the values from the defaults passed to WithDefaults that are not already set in the PipelineRun.
+func (b *PipelineRunBuilder) applyDefaults() {
+	if b.defaults == nil {
+		return
+	}
+
+	if b.pipelineRun.Spec.TaskRunTemplate.ServiceAccountName == "" {
+		b.pipelineRun.Spec.TaskRunTemplate.ServiceAccountName = b.defaults.DefaultServiceAccount
+	}
+
+	if b.defaults.DefaultTimeout > 0 && (b.pipelineRun.Spec.Timeouts == nil || b.pipelineRun.Spec.Timeouts.Pipeline == nil) {
+		if b.pipelineRun.Spec.Timeouts == nil {
+			b.pipelineRun.Spec.Timeouts = &tektonv1.TimeoutFields{}
+		}
+		b.pipelineRun.Spec.Timeouts.Pipeline = &metav1.Duration{Duration: b.defaults.DefaultTimeout}
+	}
+
+	if len(b.pipelineRun.Spec.Workspaces) == 0 && b.defaults.DefaultPVC != "" {
+		b.WithWorkspaceFromPersistentVolumeClaim(b.defaults.WorkspaceName, b.defaults.DefaultPVC, "")
+	}
+}
+
+// getPodTemplate returns the PodTemplate of the PipelineRun's TaskRunTemplate, initializing it if it's not set.
+func (b *PipelineRunBuilder) getPodTemplate() *pod.PodTemplate {
+	if b.pipelineRun.Spec.TaskRunTemplate.PodTemplate == nil {
+		b.pipelineRun.Spec.TaskRunTemplate.PodTemplate = &pod.PodTemplate{}
+	}
+
+	return b.pipelineRun.Spec.TaskRunTemplate.PodTemplate
+}
+
+// validateTimeouts accumulates an error if the sum of the tasks and finally timeouts exceeds the pipeline timeout.
+// A missing or zero pipeline timeout means no timeout, so nothing is validated in that case.
+func (b *PipelineRunBuilder) validateTimeouts(timeouts *tektonv1.TimeoutFields) {
+	if timeouts == nil || timeouts.Pipeline == nil || timeouts.Pipeline.Duration == 0 {
+		return
+	}
+
+	var sections time.Duration
+	if timeouts.Tasks != nil {
+		sections += timeouts.Tasks.Duration
+	}
+	if timeouts.Finally != nil {
+		sections += timeouts.Finally.Duration
+	}
+
+	if sections > timeouts.Pipeline.Duration {
+		b.err = multierror.Append(b.err, fmt.Errorf("the tasks and finally timeouts (%s) exceed the pipeline timeout (%s)",
+			sections, timeouts.Pipeline.Duration))
+	}
+}
+
+// newObjectReference returns the combination of the Namespace and Name of the given object used to reference it in the
+// PipelineRun params. An error is returned if either of them contains the separator, as the reference would be
+// ambiguous when parsed with ParseObjectReference.
+func newObjectReference(object client.Object) (string, error) {
+	namespacedName := types.NamespacedName{Namespace: object.GetNamespace(), Name: object.GetName()}
+	if strings.ContainsRune(namespacedName.Namespace, types.Separator) ||
+		strings.ContainsRune(namespacedName.Name, types.Separator) {
+		return "", fmt.Errorf("unable to reference %s as its namespace or name contains the %c separator",
+			namespacedName, types.Separator)
+	}
+
+	return namespacedName.String(), nil
+}
@@ -17,14 +17,20 @@ limitations under the License.
 package utils
 
 import (
+	"context"
 	"fmt"
 	"github.com/hashicorp/go-multierror"
+	applicationapiv1alpha1 "github.com/konflux-ci/application-api/api/v1alpha1"
+	"github.com/konflux-ci/release-service/metadata"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	tektonv1 "github.com/tektoncd/pipeline/pkg/apis/pipeline/v1"
+	"go.opentelemetry.io/otel/trace"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"strings"
 	"time"
 )
 
@@ -72,11 +78,203 @@ var _ = Describe("PipelineRun builder", func() {
 			}
 			_, err := builder.Build()
 			Expect(err).To(Not(BeNil()))
+			Expect(err).To(BeAssignableToTypeOf(&PipelineRunBuildError{}))
 			Expect(err.Error()).To(ContainSubstring("dummy error 1"))
 			Expect(err.Error()).To(ContainSubstring("dummy error 2"))
 		})
 	})
 
+	When("GetConfigMapValue function is called", func() {
+		var configMap *corev1.ConfigMap
+
+		BeforeEach(func() {
+			configMap = &corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{Name: "name", Namespace: "namespace"},
+				Data: map[string]string{
+					"key":   " value ",
+					"empty": "  ",
+				},
+			}
+		})
+
+		It("should return the value without leading and trailing whitespace", func() {
+			value, err := GetConfigMapValue(configMap, "key")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(value).To(Equal("value"))
+		})
+
+		It("should fail if the key is missing", func() {
+			_, err := GetConfigMapValue(configMap, "missing")
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(Equal("the missing key is missing or empty in ConfigMap namespace/name"))
+		})
+
+		It("should fail if the value only contains whitespace", func() {
+			_, err := GetConfigMapValue(configMap, "empty")
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	When("IsPipelineRunBuildError function is called", func() {
+		It("should return true for errors returned by Build", func() {
+			builder := NewPipelineRunBuilder("testPrefix", "testNamespace").WithWorkspaceFromVolumeTemplate("name", "invalid", "")
+			_, err := builder.Build()
+			Expect(IsPipelineRunBuildError(err)).To(BeTrue())
+			Expect(IsPipelineRunBuildError(fmt.Errorf("wrapped: %w", err))).To(BeTrue())
+		})
+
+		It("should return false for other errors", func() {
+			Expect(IsPipelineRunBuildError(fmt.Errorf("some error"))).To(BeFalse())
+			Expect(IsPipelineRunBuildError(nil)).To(BeFalse())
+		})
+
+		It("should return false for aggregated errors not returned by Build", func() {
+			err := multierror.Append(nil, fmt.Errorf("some error"))
+			Expect(IsPipelineRunBuildError(err)).To(BeFalse())
+			Expect(IsPipelineRunBuildError(NewPipelineRunBuilder("testPrefix", "testNamespace").Validate())).To(BeFalse())
+		})
+	})
+
+	When("ParseObjectReference function is called", func() {
+		It("should return the namespace and name of the reference", func() {
+			namespace, name, err := ParseObjectReference("namespace/name")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(namespace).To(Equal("namespace"))
+			Expect(name).To(Equal("name"))
+		})
+
+		It("should return an empty namespace for cluster scoped objects", func() {
+			namespace, name, err := ParseObjectReference("/name")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(namespace).To(BeEmpty())
+			Expect(name).To(Equal("name"))
+		})
+
+		It("should fail if the reference contains no separator", func() {
+			_, _, err := ParseObjectReference("name")
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("should fail if the reference contains more than one separator", func() {
+			_, _, err := ParseObjectReference("namespace/name/other")
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("should fail if the name is empty", func() {
+			_, _, err := ParseObjectReference("namespace/")
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("should parse the references added by the builder back into the original objects", func() {
+			objects := []*corev1.ConfigMap{
+				{ObjectMeta: metav1.ObjectMeta{Name: "name", Namespace: "namespace"}},
+				{ObjectMeta: metav1.ObjectMeta{Name: "name.with.dots", Namespace: "namespace-with-dashes"}},
+				{ObjectMeta: metav1.ObjectMeta{Name: "name:with@symbols%20", Namespace: "namespace"}},
+				{ObjectMeta: metav1.ObjectMeta{Name: "nämé-ünïcödé", Namespace: "ñamespace"}},
+				{ObjectMeta: metav1.ObjectMeta{Name: "cluster-scoped"}},
+			}
+
+			for _, object := range objects {
+				builder := NewPipelineRunBuilder("testPrefix", "testNamespace").WithObjectReferenceNamed("object", object)
+				pipelineRun, err := builder.Build()
+				Expect(err).NotTo(HaveOccurred())
+
+				namespace, name, err := ParseObjectReference(pipelineRun.Spec.Params[0].Value.StringVal)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(namespace).To(Equal(object.Namespace))
+				Expect(name).To(Equal(object.Name))
+			}
+		})
+	})
+
+	When("RemoveFinalizer method is called", func() {
+		var (
+			builder *PipelineRunBuilder
+		)
+
+		BeforeEach(func() {
+			builder = NewPipelineRunBuilder("testPrefix", "testNamespace")
+		})
+
+		It("should remove only the given finalizer", func() {
+			builder.WithFinalizer("finalizer1").WithFinalizer("finalizer2")
+			builder.RemoveFinalizer("finalizer1")
+			Expect(builder.pipelineRun.ObjectMeta.Finalizers).To(Equal([]string{"finalizer2"}))
+		})
+
+		It("should be idempotent", func() {
+			builder.WithFinalizer("finalizer1")
+			builder.RemoveFinalizer("finalizer1").RemoveFinalizer("finalizer1")
+			Expect(builder.pipelineRun.ObjectMeta.Finalizers).To(BeEmpty())
+		})
+	})
+
+	When("Validate method is called", func() {
+		var builder *PipelineRunBuilder
+
+		BeforeEach(func() {
+			builder = NewPipelineRunBuilder("testPrefix", "testNamespace").
+				WithParams(
+					tektonv1.Param{Name: "param1", Value: tektonv1.ParamValue{Type: tektonv1.ParamTypeString, StringVal: "value1"}},
+					tektonv1.Param{Name: "param2", Value: tektonv1.ParamValue{Type: tektonv1.ParamTypeString, StringVal: "value2"}},
+				).
+				WithPipelineRef(NewGitPipelineRef("url", "revision", "path").ToTektonPipelineRef()).
+				WithServiceAccount("serviceAccount")
+		})
+
+		It("should return nil for a valid PipelineRun", func() {
+			Expect(builder.Validate()).To(Succeed())
+		})
+
+		It("should accept a PipelineRef referencing a Pipeline by name", func() {
+			builder.pipelineRun.Spec.PipelineRef = &tektonv1.PipelineRef{Name: "pipeline"}
+			Expect(builder.Validate()).To(Succeed())
+		})
+
+		It("should fail if the PipelineRef is not set", func() {
+			builder.pipelineRun.Spec.PipelineRef = nil
+			err := builder.Validate()
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("the PipelineRef is not set"))
+		})
+
+		It("should accept an embedded PipelineSpec instead of a PipelineRef", func() {
+			builder.pipelineRun.Spec.PipelineRef = nil
+			builder.pipelineRun.Spec.PipelineSpec = &tektonv1.PipelineSpec{}
+			Expect(builder.Validate()).To(Succeed())
+		})
+
+		It("should fail if both the PipelineRef and the PipelineSpec are set", func() {
+			builder.pipelineRun.Spec.PipelineSpec = &tektonv1.PipelineSpec{}
+			err := builder.Validate()
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("the PipelineRef and the PipelineSpec can't be set at the same time"))
+		})
+
+		It("should fail if the PipelineRef doesn't define a name or a resolver", func() {
+			builder.pipelineRun.Spec.PipelineRef = &tektonv1.PipelineRef{}
+			err := builder.Validate()
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("the PipelineRef doesn't define a name or a resolver"))
+		})
+
+		It("should fail if the ServiceAccount is not set", func() {
+			builder.pipelineRun.Spec.TaskRunTemplate.ServiceAccountName = ""
+			err := builder.Validate()
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("the ServiceAccount is not set"))
+		})
+
+		It("should return all the problems found", func() {
+			builder.pipelineRun.Spec.PipelineRef = nil
+			builder.pipelineRun.Spec.TaskRunTemplate.ServiceAccountName = ""
+			err := builder.Validate()
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("the PipelineRef is not set"))
+			Expect(err.Error()).To(ContainSubstring("the ServiceAccount is not set"))
+		})
+	})
+
 	When("WithAnnotations method is called", func() {
 		var (
 			builder *PipelineRunBuilder
@@ -108,6 +306,279 @@ var _ = Describe("PipelineRun builder", func() {
 			Expect(builder.pipelineRun.ObjectMeta.Annotations).To(HaveKeyWithValue("annotation2", "value2"))
 			Expect(builder.pipelineRun.ObjectMeta.Annotations).To(HaveKeyWithValue("annotation3", "value3"))
 		})
+
+		It("should preserve the annotations added in previous calls", func() {
+			builder.WithAnnotations(map[string]string{"annotation1": "value1"}).
+				WithAnnotations(map[string]string{"annotation2": "value2"}).
+				WithAnnotations(map[string]string{"annotation3": "value3"})
+			Expect(builder.pipelineRun.ObjectMeta.Annotations).To(Equal(map[string]string{
+				"annotation1": "value1",
+				"annotation2": "value2",
+				"annotation3": "value3",
+			}))
+		})
+	})
+
+	When("WithAnnotationsWithPrefixes method is called", func() {
+		var configMap *corev1.ConfigMap
+
+		BeforeEach(func() {
+			configMap = &corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{
+					Annotations: map[string]string{
+						"release.appstudio.openshift.io/ticket": "RELEASE-123",
+						"change.example.com/number":             "CHG-456",
+						"other-annotation":                      "value",
+					},
+				},
+			}
+		})
+
+		It("should copy the annotations matching any of the prefixes", func() {
+			builder := NewPipelineRunBuilder("testPrefix", "testNamespace")
+			builder.WithAnnotationsWithPrefixes(configMap, []string{"release.appstudio.openshift.io", "change.example.com"})
+
+			Expect(builder.pipelineRun.Annotations).To(Equal(map[string]string{
+				"release.appstudio.openshift.io/ticket": "RELEASE-123",
+				"change.example.com/number":             "CHG-456",
+			}))
+		})
+
+		It("should not copy any annotation if none matches", func() {
+			builder := NewPipelineRunBuilder("testPrefix", "testNamespace")
+			builder.WithAnnotationsWithPrefixes(configMap, []string{"unknown.example.com"})
+
+			Expect(builder.pipelineRun.Annotations).To(BeEmpty())
+		})
+
+		It("should not copy any annotation if no prefix or an empty one is given", func() {
+			builder := NewPipelineRunBuilder("testPrefix", "testNamespace")
+			builder.WithAnnotationsWithPrefixes(configMap, nil).WithAnnotationsWithPrefixes(configMap, []string{""})
+
+			Expect(builder.pipelineRun.Annotations).To(BeEmpty())
+		})
+
+		It("should drop the excluded annotations", func() {
+			builder := NewPipelineRunBuilder("testPrefix", "testNamespace")
+			builder.WithAnnotationsWithPrefixes(configMap, []string{"release.appstudio.openshift.io", "change.example.com"},
+				"change.example.com/number")
+
+			Expect(builder.pipelineRun.Annotations).To(Equal(map[string]string{
+				"release.appstudio.openshift.io/ticket": "RELEASE-123",
+			}))
+			Expect(configMap.Annotations).To(HaveKey("change.example.com/number"))
+		})
+	})
+
+	When("WithApplicationSnapshot method is called", func() {
+		It("should add a parameter with the JSON representation of the Snapshot spec", func() {
+			builder := NewPipelineRunBuilder("testPrefix", "testNamespace")
+			snapshot := &applicationapiv1alpha1.Snapshot{
+				Spec: applicationapiv1alpha1.SnapshotSpec{
+					Application: "application",
+					Components: []applicationapiv1alpha1.SnapshotComponent{
+						{Name: "component", ContainerImage: "quay.io/org/image:tag"},
+					},
+				},
+			}
+
+			builder.WithApplicationSnapshot(snapshot)
+			Expect(builder.pipelineRun.Spec.Params).To(HaveLen(1))
+			Expect(builder.pipelineRun.Spec.Params[0].Name).To(Equal("snapshot_spec"))
+			Expect(builder.pipelineRun.Spec.Params[0].Value.StringVal).To(ContainSubstring(`"application":"application"`))
+			Expect(builder.pipelineRun.Spec.Params[0].Value.StringVal).To(ContainSubstring(`"containerImage":"quay.io/org/image:tag"`))
+			Expect(builder.pipelineRun.Annotations).NotTo(HaveKey(metadata.SnapshotSpecSkippedAnnotation))
+		})
+
+		It("should not add the parameter if the Snapshot spec is too large", func() {
+			builder := NewPipelineRunBuilder("testPrefix", "testNamespace")
+			snapshot := &applicationapiv1alpha1.Snapshot{
+				Spec: applicationapiv1alpha1.SnapshotSpec{
+					Application:        "application",
+					DisplayDescription: strings.Repeat("a", maxSnapshotSpecSize),
+				},
+			}
+
+			builder.WithApplicationSnapshot(snapshot)
+			Expect(builder.pipelineRun.Spec.Params).To(BeEmpty())
+			Expect(builder.pipelineRun.Annotations).To(HaveKeyWithValue(metadata.SnapshotSpecSkippedAnnotation,
+				ContainSubstring("exceeds the maximum param size")))
+
+			_, err := builder.Build()
+			Expect(err).NotTo(HaveOccurred())
+		})
+	})
+
+	When("WithComponent method is called", func() {
+		It("should add the component label", func() {
+			builder := NewPipelineRunBuilder("testPrefix", "testNamespace").WithComponent("my-component")
+			Expect(builder.pipelineRun.Labels).To(HaveKeyWithValue(metadata.ComponentNameLabel, "my-component"))
+		})
+
+		It("should keep the existing labels including the application one", func() {
+			builder := NewPipelineRunBuilder("testPrefix", "testNamespace").
+				WithLabels(map[string]string{metadata.ApplicationNameLabel: "my-application"}).
+				WithComponent("my-component")
+			Expect(builder.pipelineRun.Labels).To(HaveKeyWithValue(metadata.ApplicationNameLabel, "my-application"))
+			Expect(builder.pipelineRun.Labels).To(HaveKeyWithValue(metadata.ComponentNameLabel, "my-component"))
+		})
+
+		It("should not add the label if the component name is empty", func() {
+			builder := NewPipelineRunBuilder("testPrefix", "testNamespace").WithComponent("")
+			Expect(builder.pipelineRun.Labels).NotTo(HaveKey(metadata.ComponentNameLabel))
+		})
+	})
+
+	When("WithConfigMapWorkspace method is called", func() {
+		It("should add a workspace backed by the ConfigMap", func() {
+			builder := NewPipelineRunBuilder("testPrefix", "testNamespace")
+			builder.WithConfigMapWorkspace("config", "my-config")
+
+			Expect(builder.pipelineRun.Spec.Workspaces).To(Equal([]tektonv1.WorkspaceBinding{
+				{
+					Name: "config",
+					ConfigMap: &corev1.ConfigMapVolumeSource{
+						LocalObjectReference: corev1.LocalObjectReference{Name: "my-config"},
+					},
+				},
+			}))
+		})
+
+		It("should not add a workspace if any of the names is empty", func() {
+			builder := NewPipelineRunBuilder("testPrefix", "testNamespace")
+			builder.WithConfigMapWorkspace("", "my-config").WithConfigMapWorkspace("config", "")
+
+			Expect(builder.pipelineRun.Spec.Workspaces).To(BeEmpty())
+		})
+	})
+
+	When("WithData method is called", func() {
+		var builder *PipelineRunBuilder
+
+		BeforeEach(func() {
+			builder = NewPipelineRunBuilder("testPrefix", "testNamespace")
+		})
+
+		It("should add a data parameter with the merged data serialized with sorted keys", func() {
+			builder.WithData(
+				&runtime.RawExtension{Raw: []byte(`{"foo": "bar", "nested": {"a": 1, "b": 2}}`)},
+				&runtime.RawExtension{Raw: []byte(`{"nested": {"b": 3}, "baz": "qux"}`)},
+			)
+			Expect(builder.pipelineRun.Spec.Params).To(Equal(tektonv1.Params{{
+				Name: "data",
+				Value: tektonv1.ParamValue{
+					Type:      tektonv1.ParamTypeString,
+					StringVal: `{"baz":"qux","foo":"bar","nested":{"a":1,"b":3}}`,
+				},
+			}}))
+		})
+
+		It("should not add the parameter if there is no data", func() {
+			builder.WithData(nil, nil)
+			Expect(builder.pipelineRun.Spec.Params).To(BeEmpty())
+		})
+
+		It("should fail to build if the data is not valid", func() {
+			builder.WithData(&runtime.RawExtension{Raw: []byte(`invalid`)})
+			_, err := builder.Build()
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("failed to unmarshal data"))
+		})
+	})
+
+	When("WithDefaults method is called", func() {
+		var config PipelineRunConfig
+
+		BeforeEach(func() {
+			config = PipelineRunConfig{
+				DefaultPVC:            "release-pvc",
+				DefaultServiceAccount: "default-sa",
+				DefaultTimeout:        time.Hour,
+				WorkspaceName:         "release-workspace",
+				WorkspaceSize:         "1Gi",
+			}
+		})
+
+		It("should apply the defaults to the values that are not set", func() {
+			pipelineRun, err := NewPipelineRunBuilder("testPrefix", "testNamespace").
+				WithDefaults(config).
+				Build()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(pipelineRun.Spec.TaskRunTemplate.ServiceAccountName).To(Equal("default-sa"))
+			Expect(pipelineRun.Spec.Timeouts.Pipeline).To(Equal(&metav1.Duration{Duration: time.Hour}))
+			Expect(pipelineRun.Spec.Workspaces).To(Equal([]tektonv1.WorkspaceBinding{
+				{
+					Name:                  "release-workspace",
+					PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{ClaimName: "release-pvc"},
+				},
+			}))
+		})
+
+		It("should not override the values that are set, regardless of the call order", func() {
+			pipelineRun, err := NewPipelineRunBuilder("testPrefix", "testNamespace").
+				WithDefaults(config).
+				WithServiceAccount("pipeline-sa").
+				WithTimeoutDurations(2*time.Hour, 0, 0).
+				WithEmptyDirWorkspace("pipeline-workspace").
+				Build()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(pipelineRun.Spec.TaskRunTemplate.ServiceAccountName).To(Equal("pipeline-sa"))
+			Expect(pipelineRun.Spec.Timeouts.Pipeline).To(Equal(&metav1.Duration{Duration: 2 * time.Hour}))
+			Expect(pipelineRun.Spec.Workspaces).To(HaveLen(1))
+			Expect(pipelineRun.Spec.Workspaces[0].Name).To(Equal("pipeline-workspace"))
+		})
+
+		It("should not set a timeout or workspace if the defaults don't define them", func() {
+			pipelineRun, err := NewPipelineRunBuilder("testPrefix", "testNamespace").
+				WithDefaults(PipelineRunConfig{DefaultServiceAccount: "default-sa"}).
+				Build()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(pipelineRun.Spec.Timeouts).To(BeNil())
+			Expect(pipelineRun.Spec.Workspaces).To(BeEmpty())
+		})
+
+		It("should take the defaults into account when validating", func() {
+			builder := NewPipelineRunBuilder("testPrefix", "testNamespace").
+				WithDefaults(config).
+				WithPipelineRef(&tektonv1.PipelineRef{Name: "pipeline"})
+			Expect(builder.Validate()).To(Succeed())
+		})
+	})
+
+	When("WithDeterministicName method is called", func() {
+		It("should name the PipelineRun after the given name and attempt", func() {
+			builder := NewPipelineRunBuilder("testPrefix", "testNamespace").WithDeterministicName("my-release", 2)
+			Expect(builder.pipelineRun.Name).To(Equal("my-release-2"))
+			Expect(builder.pipelineRun.GenerateName).To(BeEmpty())
+		})
+
+		It("should truncate long names to fit the maximum length and add a short hash", func() {
+			builder := NewPipelineRunBuilder("testPrefix", "testNamespace").
+				WithDeterministicName(strings.Repeat("a", 60)+"-"+strings.Repeat("b", 10), 12)
+			Expect(builder.pipelineRun.Name).To(HaveLen(63))
+			Expect(builder.pipelineRun.Name).To(MatchRegexp("^" + strings.Repeat("a", 51) + "-[a-f0-9]{8}-12$"))
+		})
+
+		It("should return the same name across calls for the same name and attempt", func() {
+			name := strings.Repeat("a", 70)
+			builder := NewPipelineRunBuilder("testPrefix", "testNamespace").WithDeterministicName(name, 1)
+			otherBuilder := NewPipelineRunBuilder("testPrefix", "testNamespace").WithDeterministicName(name, 1)
+			Expect(builder.pipelineRun.Name).To(Equal(otherBuilder.pipelineRun.Name))
+		})
+
+		It("should return different names for long names sharing the same prefix", func() {
+			prefix := strings.Repeat("a", 60)
+			builder := NewPipelineRunBuilder("testPrefix", "testNamespace").WithDeterministicName(prefix+"-first", 1)
+			otherBuilder := NewPipelineRunBuilder("testPrefix", "testNamespace").WithDeterministicName(prefix+"-second", 1)
+			Expect(builder.pipelineRun.Name).NotTo(Equal(otherBuilder.pipelineRun.Name))
+		})
+
+		It("should replace the name when called again", func() {
+			builder := NewPipelineRunBuilder("testPrefix", "testNamespace").
+				WithDeterministicName("my-release", 1).
+				WithDeterministicName("my-release", 2)
+			Expect(builder.pipelineRun.Name).To(Equal("my-release-2"))
+		})
 	})
 
 	When("WithEmptyDirVolume method is called", func() {
@@ -141,6 +612,33 @@ var _ = Describe("PipelineRun builder", func() {
 		})
 	})
 
+	When("WithEmptyDirWorkspace method is called", func() {
+		var builder *PipelineRunBuilder
+
+		BeforeEach(func() {
+			builder = NewPipelineRunBuilder("testPrefix", "testNamespace")
+		})
+
+		It("should add a new workspace using emptyDir with the correct name", func() {
+			builder.WithEmptyDirWorkspace("scratch")
+			Expect(builder.pipelineRun.Spec.Workspaces).To(HaveLen(1))
+			Expect(builder.pipelineRun.Spec.Workspaces[0].Name).To(Equal("scratch"))
+			Expect(builder.pipelineRun.Spec.Workspaces[0].EmptyDir).To(Equal(&corev1.EmptyDirVolumeSource{}))
+		})
+
+		It("should keep the workspaces added in previous calls", func() {
+			builder.WithWorkspaceFromVolumeTemplate("data", "1Gi", "").WithEmptyDirWorkspace("scratch")
+			Expect(builder.pipelineRun.Spec.Workspaces).To(HaveLen(2))
+			Expect(builder.pipelineRun.Spec.Workspaces[0].VolumeClaimTemplate).NotTo(BeNil())
+			Expect(builder.pipelineRun.Spec.Workspaces[1].EmptyDir).NotTo(BeNil())
+		})
+
+		It("should not add a workspace if the name is empty", func() {
+			builder.WithEmptyDirWorkspace("")
+			Expect(builder.pipelineRun.Spec.Workspaces).To(BeEmpty())
+		})
+	})
+
 	When("WithFinalizer method is called", func() {
 		var (
 			builder *PipelineRunBuilder
@@ -160,6 +658,36 @@ var _ = Describe("PipelineRun builder", func() {
 			builder.WithFinalizer("finalizer2")
 			Expect(builder.pipelineRun.ObjectMeta.Finalizers).To(ContainElements("existingFinalizer", "finalizer2"))
 		})
+
+		It("should not duplicate a finalizer added twice", func() {
+			builder.WithFinalizer("finalizer1").WithFinalizer("finalizer1")
+			Expect(builder.pipelineRun.ObjectMeta.Finalizers).To(Equal([]string{"finalizer1"}))
+		})
+
+		It("should not add an empty finalizer", func() {
+			builder.WithFinalizer("")
+			Expect(builder.pipelineRun.ObjectMeta.Finalizers).To(BeEmpty())
+		})
+	})
+
+	When("WithFinalizerOptions method is called", func() {
+		var (
+			builder *PipelineRunBuilder
+		)
+
+		BeforeEach(func() {
+			builder = NewPipelineRunBuilder("testPrefix", "testNamespace")
+		})
+
+		It("should add the finalizer if the options don't skip it", func() {
+			builder.WithFinalizerOptions("finalizer1", FinalizerOptions{SkipFinalizer: false})
+			Expect(builder.pipelineRun.ObjectMeta.Finalizers).To(Equal([]string{"finalizer1"}))
+		})
+
+		It("should not add the finalizer if the options skip it", func() {
+			builder.WithFinalizerOptions("finalizer1", FinalizerOptions{SkipFinalizer: true})
+			Expect(builder.pipelineRun.ObjectMeta.Finalizers).To(BeEmpty())
+		})
 	})
 
 	When("WithLabels method is called", func() {
@@ -193,40 +721,245 @@ var _ = Describe("PipelineRun builder", func() {
 			Expect(builder.pipelineRun.ObjectMeta.Labels).To(HaveKeyWithValue("label2", "value2"))
 			Expect(builder.pipelineRun.ObjectMeta.Labels).To(HaveKeyWithValue("label3", "value3"))
 		})
-	})
-
-	When("WithObjectReferences method is called", func() {
-		It("should add parameters based on the provided client.Objects", func() {
-			builder := NewPipelineRunBuilder("testPrefix", "testNamespace")
-			configMap1 := &corev1.ConfigMap{
-				ObjectMeta: metav1.ObjectMeta{
-					Name:      "configName1",
-					Namespace: "configNamespace1",
-				},
-			}
-			configMap1.Kind = "ConfigMap"
-			configMap2 := &corev1.ConfigMap{
-				ObjectMeta: metav1.ObjectMeta{
-					Name:      "configName2",
-					Namespace: "configNamespace2",
-				},
-			}
-			configMap2.Kind = "ConfigMap"
-
-			builder.WithObjectReferences(configMap1, configMap2)
 
-			Expect(builder.pipelineRun.Spec.Params).To(ContainElement(tektonv1.Param{
-				Name:  "configMap",
-				Value: tektonv1.ParamValue{Type: tektonv1.ParamTypeString, StringVal: "configNamespace1/configName1"},
-			}))
-			Expect(builder.pipelineRun.Spec.Params).To(ContainElement(tektonv1.Param{
-				Name:  "configMap",
-				Value: tektonv1.ParamValue{Type: tektonv1.ParamTypeString, StringVal: "configNamespace2/configName2"},
+		It("should preserve the labels added in previous calls", func() {
+			builder.WithLabels(map[string]string{"label1": "value1"}).
+				WithLabels(map[string]string{"label2": "value2"}).
+				WithLabels(map[string]string{"label3": "value3"})
+			Expect(builder.pipelineRun.ObjectMeta.Labels).To(Equal(map[string]string{
+				"label1": "value1",
+				"label2": "value2",
+				"label3": "value3",
 			}))
 		})
 	})
 
-	When("WithObjectSpecsAsJson method is called", func() {
+	When("WithNodeSelector method is called", func() {
+		It("should initialize the PodTemplate and set the node selector", func() {
+			builder := NewPipelineRunBuilder("testPrefix", "testNamespace")
+			builder.WithNodeSelector(map[string]string{"node-role": "release"})
+			Expect(builder.pipelineRun.Spec.TaskRunTemplate.PodTemplate).NotTo(BeNil())
+			Expect(builder.pipelineRun.Spec.TaskRunTemplate.PodTemplate.NodeSelector).To(Equal(map[string]string{"node-role": "release"}))
+		})
+
+		It("should merge the node selector with the existing one", func() {
+			builder := NewPipelineRunBuilder("testPrefix", "testNamespace")
+			builder.WithNodeSelector(map[string]string{"node-role": "release", "zone": "a"})
+			builder.WithNodeSelector(map[string]string{"zone": "b"})
+			Expect(builder.pipelineRun.Spec.TaskRunTemplate.PodTemplate.NodeSelector).To(Equal(map[string]string{
+				"node-role": "release",
+				"zone":      "b",
+			}))
+		})
+
+		It("should not overwrite the tolerations", func() {
+			builder := NewPipelineRunBuilder("testPrefix", "testNamespace")
+			tolerations := []corev1.Toleration{{Key: "dedicated", Operator: corev1.TolerationOpExists}}
+			builder.WithTolerations(tolerations).WithNodeSelector(map[string]string{"node-role": "release"})
+			Expect(builder.pipelineRun.Spec.TaskRunTemplate.PodTemplate.Tolerations).To(Equal(tolerations))
+			Expect(builder.pipelineRun.Spec.TaskRunTemplate.PodTemplate.NodeSelector).To(HaveKey("node-role"))
+		})
+
+		It("should leave the PodTemplate unset when the node selector is empty", func() {
+			builder := NewPipelineRunBuilder("testPrefix", "testNamespace")
+			builder.WithNodeSelector(nil)
+			Expect(builder.pipelineRun.Spec.TaskRunTemplate.PodTemplate).To(BeNil())
+		})
+	})
+
+	When("WithObjectParam method is called", func() {
+		It("should add an object param with the given value", func() {
+			builder := NewPipelineRunBuilder("testPrefix", "testNamespace")
+			builder.WithObjectParam("image", map[string]string{"url": "quay.io/org/image", "digest": "sha256:abc"})
+
+			Expect(builder.pipelineRun.Spec.Params).To(HaveLen(1))
+			Expect(builder.pipelineRun.Spec.Params[0].Name).To(Equal("image"))
+			Expect(builder.pipelineRun.Spec.Params[0].Value.Type).To(Equal(tektonv1.ParamTypeObject))
+			Expect(builder.pipelineRun.Spec.Params[0].Value.ObjectVal).To(Equal(map[string]string{
+				"url":    "quay.io/org/image",
+				"digest": "sha256:abc",
+			}))
+		})
+
+		It("should add an empty object if the value is nil", func() {
+			builder := NewPipelineRunBuilder("testPrefix", "testNamespace")
+			builder.WithObjectParam("image", nil)
+
+			Expect(builder.pipelineRun.Spec.Params[0].Value.Type).To(Equal(tektonv1.ParamTypeObject))
+			Expect(builder.pipelineRun.Spec.Params[0].Value.ObjectVal).NotTo(BeNil())
+			Expect(builder.pipelineRun.Spec.Params[0].Value.ObjectVal).To(BeEmpty())
+		})
+
+		It("should not be affected by later changes to the given value", func() {
+			value := map[string]string{"url": "quay.io/org/image"}
+			builder := NewPipelineRunBuilder("testPrefix", "testNamespace")
+			builder.WithObjectParam("image", value)
+			value["url"] = "changed"
+
+			Expect(builder.pipelineRun.Spec.Params[0].Value.ObjectVal).To(HaveKeyWithValue("url", "quay.io/org/image"))
+		})
+
+		It("should replace a param with the same name", func() {
+			builder := NewPipelineRunBuilder("testPrefix", "testNamespace")
+			builder.WithParamIfNotEmpty("image", "quay.io/org/image").
+				WithObjectParam("image", map[string]string{"url": "quay.io/org/image"})
+
+			Expect(builder.pipelineRun.Spec.Params).To(HaveLen(1))
+			Expect(builder.pipelineRun.Spec.Params[0].Value.Type).To(Equal(tektonv1.ParamTypeObject))
+		})
+	})
+
+	When("WithObjectReferenceNamed method is called", func() {
+		It("should add a param for each object using the given names", func() {
+			builder := NewPipelineRunBuilder("testPrefix", "testNamespace")
+			builder.WithObjectReferenceNamed("sourceConfig", &corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{Name: "source", Namespace: "default"},
+			}).WithObjectReferenceNamed("targetConfig", &corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{Name: "target", Namespace: "default"},
+			})
+
+			Expect(builder.err).To(BeNil())
+			Expect(builder.pipelineRun.Spec.Params).To(ContainElements(
+				tektonv1.Param{Name: "sourceConfig", Value: *tektonv1.NewStructuredValues("default/source")},
+				tektonv1.Param{Name: "targetConfig", Value: *tektonv1.NewStructuredValues("default/target")},
+			))
+		})
+
+		It("should fail to build if the param name is already used", func() {
+			configMap := &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "source", Namespace: "default"}}
+			builder := NewPipelineRunBuilder("testPrefix", "testNamespace")
+			builder.WithObjectReferenceNamed("config", configMap).WithObjectReferenceNamed("config", configMap)
+
+			_, err := builder.Build()
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	When("WithObjectReferences method is called", func() {
+		It("should add parameters based on the provided client.Objects", func() {
+			builder := NewPipelineRunBuilder("testPrefix", "testNamespace")
+			configMap1 := &corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "configName1",
+					Namespace: "configNamespace1",
+				},
+			}
+			configMap1.Kind = "ConfigMap"
+			secret := &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "secretName",
+					Namespace: "secretNamespace",
+				},
+			}
+			secret.Kind = "Secret"
+
+			builder.WithObjectReferences(configMap1, secret)
+
+			Expect(builder.pipelineRun.Spec.Params).To(ContainElement(tektonv1.Param{
+				Name:  "configMap",
+				Value: tektonv1.ParamValue{Type: tektonv1.ParamTypeString, StringVal: "configNamespace1/configName1"},
+			}))
+			Expect(builder.pipelineRun.Spec.Params).To(ContainElement(tektonv1.Param{
+				Name:  "secret",
+				Value: tektonv1.ParamValue{Type: tektonv1.ParamTypeString, StringVal: "secretNamespace/secretName"},
+			}))
+		})
+
+		It("should fail to build if two objects have the same Kind", func() {
+			builder := NewPipelineRunBuilder("testPrefix", "testNamespace")
+			configMap1 := &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "configName1", Namespace: "configNamespace1"}}
+			configMap1.Kind = "ConfigMap"
+			configMap2 := &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "configName2", Namespace: "configNamespace2"}}
+			configMap2.Kind = "ConfigMap"
+
+			builder.WithObjectReferences(configMap1).WithObjectReferences(configMap2)
+
+			Expect(builder.pipelineRun.Spec.Params).To(Equal(tektonv1.Params{{
+				Name:  "configMap",
+				Value: tektonv1.ParamValue{Type: tektonv1.ParamTypeString, StringVal: "configNamespace1/configName1"},
+			}}))
+			_, err := builder.Build()
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("the configMap param is already used by another object reference"))
+		})
+
+		It("should fail to build if the object name contains the separator", func() {
+			builder := NewPipelineRunBuilder("testPrefix", "testNamespace")
+			configMap := &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "config/name", Namespace: "namespace"}}
+			configMap.Kind = "ConfigMap"
+
+			builder.WithObjectReferences(configMap)
+
+			Expect(builder.pipelineRun.Spec.Params).To(BeEmpty())
+			_, err := builder.Build()
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("contains the / separator"))
+		})
+
+		It("should fail to build if the object namespace contains the separator", func() {
+			builder := NewPipelineRunBuilder("testPrefix", "testNamespace")
+			configMap := &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "name", Namespace: "config/namespace"}}
+			configMap.Kind = "ConfigMap"
+
+			builder.WithObjectReferences(configMap)
+
+			Expect(builder.pipelineRun.Spec.Params).To(BeEmpty())
+			_, err := builder.Build()
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("should fail to build if the object has no Kind", func() {
+			builder := NewPipelineRunBuilder("testPrefix", "testNamespace")
+			builder.WithObjectReferences(&corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "name", Namespace: "namespace"}})
+
+			Expect(builder.pipelineRun.Spec.Params).To(BeEmpty())
+			_, err := builder.Build()
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	When("WithObjectReferencesAsArray method is called", func() {
+		It("should add an array parameter with the references in the same order", func() {
+			builder := NewPipelineRunBuilder("testPrefix", "testNamespace")
+			configMap1 := &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "configName1", Namespace: "configNamespace1"}}
+			configMap2 := &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "configName2", Namespace: "configNamespace2"}}
+
+			builder.WithObjectReferencesAsArray("configMaps", configMap2, configMap1)
+
+			Expect(builder.pipelineRun.Spec.Params).To(Equal(tektonv1.Params{{
+				Name: "configMaps",
+				Value: tektonv1.ParamValue{
+					Type:     tektonv1.ParamTypeArray,
+					ArrayVal: []string{"configNamespace2/configName2", "configNamespace1/configName1"},
+				},
+			}}))
+		})
+
+		It("should add an empty array parameter if no objects are passed", func() {
+			builder := NewPipelineRunBuilder("testPrefix", "testNamespace")
+
+			builder.WithObjectReferencesAsArray("configMaps")
+
+			Expect(builder.pipelineRun.Spec.Params).To(HaveLen(1))
+			Expect(builder.pipelineRun.Spec.Params[0].Value.Type).To(Equal(tektonv1.ParamTypeArray))
+			Expect(builder.pipelineRun.Spec.Params[0].Value.ArrayVal).NotTo(BeNil())
+			Expect(builder.pipelineRun.Spec.Params[0].Value.ArrayVal).To(BeEmpty())
+		})
+
+		It("should fail to build if an object name contains the separator", func() {
+			builder := NewPipelineRunBuilder("testPrefix", "testNamespace")
+			configMap1 := &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "configName1", Namespace: "configNamespace1"}}
+			configMap2 := &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "config/name2", Namespace: "configNamespace2"}}
+
+			builder.WithObjectReferencesAsArray("configMaps", configMap1, configMap2)
+
+			Expect(builder.pipelineRun.Spec.Params[0].Value.ArrayVal).To(Equal([]string{"configNamespace1/configName1"}))
+			_, err := builder.Build()
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	When("WithObjectSpecsAsJson method is called", func() {
 		It("should add parameters with JSON representation of the object's Spec", func() {
 			builder := NewPipelineRunBuilder("testPrefix", "testNamespace")
 			pod1 := &corev1.Pod{
@@ -252,7 +985,7 @@ var _ = Describe("PipelineRun builder", func() {
 			}
 			pod2.Kind = "Pod"
 
-			builder.WithObjectSpecsAsJson(pod1, pod2)
+			builder.WithObjectSpecsAsJson(pod1)
 
 			Expect(builder.pipelineRun.Spec.Params).To(ContainElement(tektonv1.Param{
 				Name: "pod",
@@ -261,6 +994,11 @@ var _ = Describe("PipelineRun builder", func() {
 					StringVal: `{"containers":[{"name":"container1","image":"image1","resources":{}}]}`,
 				},
 			}))
+
+			// Objects of the same Kind replace the param added for the previous ones
+			builder.WithObjectSpecsAsJson(pod2)
+
+			Expect(builder.pipelineRun.Spec.Params).To(HaveLen(1))
 			Expect(builder.pipelineRun.Spec.Params).To(ContainElement(tektonv1.Param{
 				Name: "pod",
 				Value: tektonv1.ParamValue{
@@ -269,6 +1007,69 @@ var _ = Describe("PipelineRun builder", func() {
 				},
 			}))
 		})
+
+		It("should use the type name of the object if its Kind is empty", func() {
+			builder := NewPipelineRunBuilder("testPrefix", "testNamespace")
+			pod := &corev1.Pod{
+				Spec: corev1.PodSpec{
+					Containers: []corev1.Container{{Name: "container", Image: "image"}},
+				},
+			}
+
+			Expect(func() { builder.WithObjectSpecsAsJson(pod) }).NotTo(Panic())
+			Expect(builder.err).To(BeNil())
+			Expect(builder.pipelineRun.Spec.Params).To(ContainElement(HaveField("Name", "pod")))
+		})
+
+		It("should fail to build if an object doesn't have a Spec", func() {
+			builder := NewPipelineRunBuilder("testPrefix", "testNamespace")
+			configMap := &corev1.ConfigMap{}
+			configMap.Kind = "ConfigMap"
+
+			builder.WithObjectSpecsAsJson(configMap)
+			Expect(builder.pipelineRun.Spec.Params).To(BeEmpty())
+
+			_, err := builder.Build()
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("failed to extract spec for object: configMap"))
+		})
+
+		It("should fail to build if an object Spec can't be serialized", func() {
+			builder := NewPipelineRunBuilder("testPrefix", "testNamespace")
+			object := &unserializableObject{}
+			object.Kind = "Unserializable"
+
+			builder.WithObjectSpecsAsJson(object)
+			Expect(builder.pipelineRun.Spec.Params).To(BeEmpty())
+
+			_, err := builder.Build()
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("failed to serialize spec of object unserializable to JSON"))
+		})
+	})
+
+	When("WithParamIfNotEmpty method is called", func() {
+		It("should add a string param if the value is not empty", func() {
+			builder := NewPipelineRunBuilder("testPrefix", "testNamespace")
+			builder.WithParamIfNotEmpty("foo", "bar")
+
+			Expect(builder.pipelineRun.Spec.Params).To(Equal(tektonv1.Params{
+				{
+					Name: "foo",
+					Value: tektonv1.ParamValue{
+						Type:      tektonv1.ParamTypeString,
+						StringVal: "bar",
+					},
+				},
+			}))
+		})
+
+		It("should not add any param if the value is empty", func() {
+			builder := NewPipelineRunBuilder("testPrefix", "testNamespace")
+			Expect(builder.WithParamIfNotEmpty("foo", "")).To(Equal(builder))
+
+			Expect(builder.pipelineRun.Spec.Params).To(BeEmpty())
+		})
 	})
 
 	When("WithParams method is called", func() {
@@ -288,6 +1089,88 @@ var _ = Describe("PipelineRun builder", func() {
 
 			Expect(builder.pipelineRun.Spec.Params).To(ContainElements(param1, param2))
 		})
+
+		It("should replace the value of params with the same name", func() {
+			builder := NewPipelineRunBuilder("testPrefix", "testNamespace")
+
+			builder.WithParams(
+				tektonv1.Param{Name: "param1", Value: tektonv1.ParamValue{Type: tektonv1.ParamTypeString, StringVal: "value1"}},
+				tektonv1.Param{Name: "param2", Value: tektonv1.ParamValue{Type: tektonv1.ParamTypeString, StringVal: "value2"}},
+			)
+			builder.WithParams(
+				tektonv1.Param{Name: "param1", Value: tektonv1.ParamValue{Type: tektonv1.ParamTypeString, StringVal: "override"}},
+			)
+
+			Expect(builder.pipelineRun.Spec.Params).To(HaveLen(2))
+			Expect(builder.pipelineRun.Spec.Params[0].Value.StringVal).To(Equal("override"))
+			Expect(builder.pipelineRun.Spec.Params[1].Value.StringVal).To(Equal("value2"))
+		})
+
+		It("should not be affected by changes to the given params after the call", func() {
+			builder := NewPipelineRunBuilder("testPrefix", "testNamespace")
+			params := []tektonv1.Param{
+				{Name: "array", Value: *tektonv1.NewStructuredValues("foo", "bar")},
+				{Name: "object", Value: *tektonv1.NewObject(map[string]string{"key": "value"})},
+			}
+			builder.WithParams(params...)
+
+			params[0].Value.ArrayVal[0] = "changed"
+			params[1].Value.ObjectVal["key"] = "changed"
+			params[1].Name = "changed"
+
+			Expect(builder.pipelineRun.Spec.Params[0].Value.ArrayVal).To(Equal([]string{"foo", "bar"}))
+			Expect(builder.pipelineRun.Spec.Params[1].Name).To(Equal("object"))
+			Expect(builder.pipelineRun.Spec.Params[1].Value.ObjectVal).To(HaveKeyWithValue("key", "value"))
+		})
+
+		It("should keep the type of each param when mixing string and array params", func() {
+			builder := NewPipelineRunBuilder("testPrefix", "testNamespace")
+			params := []tektonv1.Param{
+				{Name: "string1", Value: tektonv1.ParamValue{Type: tektonv1.ParamTypeString, StringVal: "value1"}},
+				{Name: "array1", Value: tektonv1.ParamValue{Type: tektonv1.ParamTypeArray, ArrayVal: []string{"a", "b"}}},
+				{Name: "string2", Value: tektonv1.ParamValue{Type: tektonv1.ParamTypeString, StringVal: "value2"}},
+				{Name: "array2", Value: tektonv1.ParamValue{Type: tektonv1.ParamTypeArray, ArrayVal: []string{"c"}}},
+			}
+
+			builder.WithParams(params...)
+
+			Expect(builder.pipelineRun.Spec.Params).To(Equal(tektonv1.Params(params)))
+		})
+
+		It("should replace an array param with a string param of the same name", func() {
+			builder := NewPipelineRunBuilder("testPrefix", "testNamespace")
+			stringParam := tektonv1.Param{
+				Name:  "param",
+				Value: tektonv1.ParamValue{Type: tektonv1.ParamTypeString, StringVal: "value"},
+			}
+
+			builder.WithParams(tektonv1.Param{
+				Name:  "param",
+				Value: tektonv1.ParamValue{Type: tektonv1.ParamTypeArray, ArrayVal: []string{"value1", "value2"}},
+			})
+			builder.WithParams(stringParam)
+
+			Expect(builder.pipelineRun.Spec.Params).To(Equal(tektonv1.Params{stringParam}))
+		})
+
+		It("should never produce duplicate param names when combined with other methods", func() {
+			configMap := &corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{Name: "configMap", Namespace: "namespace"},
+				Data:       map[string]string{"configMap": "value"},
+			}
+			configMap.Kind = "ConfigMap"
+
+			builder := NewPipelineRunBuilder("testPrefix", "testNamespace").
+				WithParamsFromConfigMap(configMap, "configMap").
+				WithParams(tektonv1.Param{Name: "configMap", Value: tektonv1.ParamValue{Type: tektonv1.ParamTypeString, StringVal: "user"}}).
+				WithObjectReferences(configMap)
+
+			Expect(builder.pipelineRun.Spec.Params).To(HaveLen(1))
+			Expect(builder.pipelineRun.Spec.Params).To(ContainElement(tektonv1.Param{
+				Name:  "configMap",
+				Value: tektonv1.ParamValue{Type: tektonv1.ParamTypeString, StringVal: "namespace/configMap"},
+			}))
+		})
 	})
 
 	When("WithOwner method is called", func() {
@@ -307,6 +1190,11 @@ var _ = Describe("PipelineRun builder", func() {
 			configMap.Kind = "Config"
 		})
 
+		It("should not add any finalizer", func() {
+			builder.WithOwner(configMap)
+			Expect(builder.pipelineRun.ObjectMeta.Finalizers).To(BeEmpty())
+		})
+
 		It("should handle owner without errors", func() {
 			builder.WithOwner(configMap)
 			_, err := builder.Build()
@@ -317,6 +1205,60 @@ var _ = Describe("PipelineRun builder", func() {
 			builder.WithOwner(configMap)
 			Expect(builder.pipelineRun.Annotations).ToNot(BeEmpty())
 		})
+
+		It("should produce the same metadata regardless of the order of the metadata methods", func() {
+			annotations := map[string]string{"annotation": "value"}
+			labels := map[string]string{"label": "value"}
+
+			builder.WithOwner(configMap).WithAnnotations(annotations).WithLabels(labels).WithFinalizer("finalizer")
+			otherBuilder := NewPipelineRunBuilder("testPrefix", "testNamespace").
+				WithFinalizer("finalizer").WithLabels(labels).WithAnnotations(annotations).WithOwner(configMap)
+
+			Expect(builder.pipelineRun.Annotations).To(HaveLen(3))
+			Expect(builder.pipelineRun.Annotations).To(Equal(otherBuilder.pipelineRun.Annotations))
+			Expect(builder.pipelineRun.Labels).To(Equal(otherBuilder.pipelineRun.Labels))
+			Expect(builder.pipelineRun.Finalizers).To(Equal(otherBuilder.pipelineRun.Finalizers))
+		})
+	})
+
+	When("WithOwnerReference method is called", func() {
+		var (
+			configMap *corev1.ConfigMap
+			scheme    *runtime.Scheme
+		)
+
+		BeforeEach(func() {
+			scheme = runtime.NewScheme()
+			Expect(corev1.AddToScheme(scheme)).To(Succeed())
+			configMap = &corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "configName",
+					Namespace: "testNamespace",
+					UID:       "uid",
+				},
+			}
+		})
+
+		It("should set a controller owner reference when the owner is in the same namespace", func() {
+			builder := NewPipelineRunBuilder("testPrefix", "testNamespace").WithOwnerReference(configMap, scheme)
+			Expect(builder.err).To(BeNil())
+			Expect(builder.pipelineRun.OwnerReferences).To(HaveLen(1))
+			Expect(builder.pipelineRun.OwnerReferences[0].Kind).To(Equal("ConfigMap"))
+			Expect(builder.pipelineRun.OwnerReferences[0].Name).To(Equal("configName"))
+			Expect(*builder.pipelineRun.OwnerReferences[0].Controller).To(BeTrue())
+		})
+
+		It("should not set an owner reference when the owner is in a different namespace", func() {
+			builder := NewPipelineRunBuilder("testPrefix", "otherNamespace").WithOwnerReference(configMap, scheme)
+			Expect(builder.err).To(BeNil())
+			Expect(builder.pipelineRun.OwnerReferences).To(BeEmpty())
+		})
+
+		It("should fail if the owner type is not registered in the scheme", func() {
+			builder := NewPipelineRunBuilder("testPrefix", "testNamespace").WithOwnerReference(configMap, runtime.NewScheme())
+			Expect(builder.err).To(HaveOccurred())
+			Expect(builder.err.Error()).To(ContainSubstring("failed to set owner reference"))
+		})
 	})
 
 	When("WithParamsFromConfigMap method is called", func() {
@@ -329,7 +1271,7 @@ var _ = Describe("PipelineRun builder", func() {
 				},
 			}
 
-			builder.WithParamsFromConfigMap(configMap, []string{"key1", "key2", "key3"}) // "key3" doesn't exist in the ConfigMap.
+			builder.WithParamsFromConfigMap(configMap, "key1", "key2", "key3") // "key3" doesn't exist in the ConfigMap.
 
 			paramKey1 := tektonv1.Param{
 				Name:  "key1",
@@ -348,6 +1290,76 @@ var _ = Describe("PipelineRun builder", func() {
 				Expect(param.Name).ToNot(Equal("key3"))
 			}
 		})
+
+		It("should not add any parameter when none of the keys exist in the ConfigMap", func() {
+			builder := NewPipelineRunBuilder("testPrefix", "testNamespace")
+			configMap := &corev1.ConfigMap{Data: map[string]string{"key1": "value1"}}
+
+			builder.WithParamsFromConfigMap(configMap, "key2", "key3")
+			Expect(builder.pipelineRun.Spec.Params).To(BeEmpty())
+		})
+
+		It("should not add any parameter when no keys are passed", func() {
+			builder := NewPipelineRunBuilder("testPrefix", "testNamespace")
+			configMap := &corev1.ConfigMap{Data: map[string]string{"key1": "value1"}}
+
+			builder.WithParamsFromConfigMap(configMap)
+			Expect(builder.pipelineRun.Spec.Params).To(BeEmpty())
+		})
+
+		It("should not add any parameter when the ConfigMap is nil", func() {
+			builder := NewPipelineRunBuilder("testPrefix", "testNamespace")
+
+			builder.WithParamsFromConfigMap(nil, "key1")
+			Expect(builder.pipelineRun.Spec.Params).To(BeEmpty())
+		})
+	})
+
+	When("WithPinnedBundle method is called", func() {
+		digest := "sha256:" + strings.Repeat("a", 64)
+
+		It("should set a bundles PipelineRef if the bundle is pinned by digest", func() {
+			builder := NewPipelineRunBuilder("testPrefix", "testNamespace")
+			builder.WithPinnedBundle("quay.io/org/pipelines@"+digest, "release")
+
+			Expect(builder.err).To(BeNil())
+			Expect(builder.pipelineRun.Spec.PipelineRef.Resolver).To(Equal(tektonv1.ResolverName("bundles")))
+			Expect(builder.pipelineRun.Spec.PipelineRef.Params).To(ContainElement(tektonv1.Param{
+				Name:  "bundle",
+				Value: *tektonv1.NewStructuredValues("quay.io/org/pipelines@" + digest),
+			}))
+			Expect(builder.pipelineRun.Spec.PipelineRef.Params).To(ContainElement(tektonv1.Param{
+				Name:  "name",
+				Value: *tektonv1.NewStructuredValues("release"),
+			}))
+		})
+
+		It("should accept a bundle with both a tag and a digest", func() {
+			builder := NewPipelineRunBuilder("testPrefix", "testNamespace")
+			builder.WithPinnedBundle("quay.io/org/pipelines:v1@"+digest, "release")
+
+			Expect(builder.err).To(BeNil())
+		})
+
+		It("should fail to build if the bundle only has a tag", func() {
+			builder := NewPipelineRunBuilder("testPrefix", "testNamespace")
+			builder.WithPinnedBundle("quay.io/org/pipelines:v1", "release")
+
+			Expect(builder.pipelineRun.Spec.PipelineRef).To(BeNil())
+			_, err := builder.Build()
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("is not pinned by a sha256 digest"))
+		})
+
+		It("should fail to build if the bundle is malformed", func() {
+			for _, bundle := range []string{"", "@" + digest, "quay.io/org/pipelines@sha256:abc", "quay.io/org/pipelines@md5:" + strings.Repeat("a", 64)} {
+				builder := NewPipelineRunBuilder("testPrefix", "testNamespace")
+				builder.WithPinnedBundle(bundle, "release")
+
+				_, err := builder.Build()
+				Expect(err).To(HaveOccurred())
+			}
+		})
 	})
 
 	When("WithPipelineRef method is called", func() {
@@ -387,16 +1399,491 @@ var _ = Describe("PipelineRun builder", func() {
 		})
 	})
 
-	When("WithServiceAccount method is called", func() {
-		It("should set the ServiceAccountName for the PipelineRun's TaskRunTemplate", func() {
-			builder := NewPipelineRunBuilder("testPrefix", "testNamespace")
-			serviceAccount := "sampleServiceAccount"
-			builder.WithServiceAccount(serviceAccount)
-			Expect(builder.pipelineRun.Spec.TaskRunTemplate.ServiceAccountName).To(Equal(serviceAccount))
+	When("WithPipelineSpec method is called", func() {
+		It("should embed a copy of the PipelineSpec", func() {
+			pipelineSpec := &tektonv1.PipelineSpec{
+				Tasks: []tektonv1.PipelineTask{{Name: "push"}},
+			}
+			builder := NewPipelineRunBuilder("testPrefix", "testNamespace").WithPipelineSpec(pipelineSpec)
+			Expect(builder.pipelineRun.Spec.PipelineSpec).To(Equal(pipelineSpec))
+
+			pipelineSpec.Tasks[0].Name = "changed"
+			Expect(builder.pipelineRun.Spec.PipelineSpec.Tasks[0].Name).To(Equal("push"))
 		})
 	})
 
-	When("WithTaskRunSpecs method is called", func() {
+	When("WithPipelinesAsCodeAnnotations method is called", func() {
+		var configMap *corev1.ConfigMap
+
+		BeforeEach(func() {
+			configMap = &corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{
+					Annotations: map[string]string{
+						metadata.PipelinesAsCodePrefix + "/event-type": "push",
+						metadata.PipelinesAsCodePrefix + "/internal":   "value",
+						"other-annotation": "value",
+					},
+				},
+			}
+		})
+
+		It("should only copy the Pipelines-as-Code annotations", func() {
+			builder := NewPipelineRunBuilder("testPrefix", "testNamespace")
+			builder.WithPipelinesAsCodeAnnotations(configMap)
+
+			Expect(builder.pipelineRun.Annotations).To(Equal(map[string]string{
+				metadata.PipelinesAsCodePrefix + "/event-type": "push",
+				metadata.PipelinesAsCodePrefix + "/internal":   "value",
+			}))
+		})
+
+		It("should drop the excluded annotations", func() {
+			builder := NewPipelineRunBuilder("testPrefix", "testNamespace")
+			builder.WithPipelinesAsCodeAnnotations(configMap, metadata.PipelinesAsCodePrefix+"/internal")
+
+			Expect(builder.pipelineRun.Annotations).To(Equal(map[string]string{
+				metadata.PipelinesAsCodePrefix + "/event-type": "push",
+			}))
+			Expect(configMap.Annotations).To(HaveKey(metadata.PipelinesAsCodePrefix + "/internal"))
+		})
+	})
+
+	When("WithPodTemplate method is called", func() {
+		It("should set the node selector and tolerations in the PipelineRun's TaskRunTemplate", func() {
+			builder := NewPipelineRunBuilder("testPrefix", "testNamespace")
+			nodeSelector := map[string]string{"node-role": "release"}
+			tolerations := []corev1.Toleration{
+				{Key: "dedicated", Operator: corev1.TolerationOpEqual, Value: "release", Effect: corev1.TaintEffectNoSchedule},
+			}
+			builder.WithPodTemplate(nodeSelector, tolerations)
+			Expect(builder.pipelineRun.Spec.TaskRunTemplate.PodTemplate).NotTo(BeNil())
+			Expect(builder.pipelineRun.Spec.TaskRunTemplate.PodTemplate.NodeSelector).To(Equal(nodeSelector))
+			Expect(builder.pipelineRun.Spec.TaskRunTemplate.PodTemplate.Tolerations).To(Equal(tolerations))
+		})
+
+		It("should set the PodTemplate when only tolerations are passed", func() {
+			builder := NewPipelineRunBuilder("testPrefix", "testNamespace")
+			builder.WithPodTemplate(nil, []corev1.Toleration{{Key: "dedicated", Operator: corev1.TolerationOpExists}})
+			Expect(builder.pipelineRun.Spec.TaskRunTemplate.PodTemplate).NotTo(BeNil())
+			Expect(builder.pipelineRun.Spec.TaskRunTemplate.PodTemplate.NodeSelector).To(BeNil())
+			Expect(builder.pipelineRun.Spec.TaskRunTemplate.PodTemplate.Tolerations).To(HaveLen(1))
+		})
+
+		It("should leave the PodTemplate unset when both inputs are empty", func() {
+			builder := NewPipelineRunBuilder("testPrefix", "testNamespace")
+			builder.WithPodTemplate(map[string]string{}, nil)
+			Expect(builder.pipelineRun.Spec.TaskRunTemplate.PodTemplate).To(BeNil())
+		})
+	})
+
+	When("WithPriorityClass method is called", func() {
+		It("should set the PriorityClass name in the PodTemplate", func() {
+			builder := NewPipelineRunBuilder("testPrefix", "testNamespace")
+			builder.WithPriorityClass("release-critical")
+
+			podTemplate := builder.pipelineRun.Spec.TaskRunTemplate.PodTemplate
+			Expect(podTemplate).NotTo(BeNil())
+			Expect(*podTemplate.PriorityClassName).To(Equal("release-critical"))
+		})
+
+		It("should keep the existing values of the PodTemplate", func() {
+			builder := NewPipelineRunBuilder("testPrefix", "testNamespace")
+			builder.WithNodeSelector(map[string]string{"node-role": "release"}).WithPriorityClass("release-critical")
+
+			podTemplate := builder.pipelineRun.Spec.TaskRunTemplate.PodTemplate
+			Expect(podTemplate.NodeSelector).To(HaveKeyWithValue("node-role", "release"))
+			Expect(*podTemplate.PriorityClassName).To(Equal("release-critical"))
+		})
+
+		It("should leave the PodTemplate untouched if the name is empty", func() {
+			builder := NewPipelineRunBuilder("testPrefix", "testNamespace")
+			builder.WithPriorityClass("")
+
+			Expect(builder.pipelineRun.Spec.TaskRunTemplate.PodTemplate).To(BeNil())
+		})
+	})
+
+	When("WithRegistryAuthParam method is called", func() {
+		It("should add a string param pointing to the Secret", func() {
+			builder := NewPipelineRunBuilder("testPrefix", "testNamespace")
+			builder.WithRegistryAuthParam("registry-secret")
+
+			Expect(builder.pipelineRun.Spec.Params).To(Equal(tektonv1.Params{
+				{
+					Name: "registry_auth_secret",
+					Value: tektonv1.ParamValue{
+						Type:      tektonv1.ParamTypeString,
+						StringVal: "registry-secret",
+					},
+				},
+			}))
+		})
+
+		It("should not add any param if the Secret name is empty", func() {
+			builder := NewPipelineRunBuilder("testPrefix", "testNamespace")
+			builder.WithRegistryAuthParam("")
+
+			Expect(builder.pipelineRun.Spec.Params).To(BeEmpty())
+		})
+	})
+
+	When("WithReleaseUID method is called", func() {
+		It("should add a string param containing the UID of the Release", func() {
+			// The Release type can't be used here without an import cycle, so a ConfigMap stands in for it
+			release := &corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "release",
+					Namespace: "default",
+					UID:       "2f9b8c3e-0d6a-4c1e-9f3b-7a5d1e2c4b6a",
+				},
+			}
+			builder := NewPipelineRunBuilder("testPrefix", "testNamespace").WithReleaseUID(release)
+
+			Expect(builder.pipelineRun.Spec.Params).To(Equal(tektonv1.Params{
+				{
+					Name: ReleaseUIDParamName,
+					Value: tektonv1.ParamValue{
+						Type:      tektonv1.ParamTypeString,
+						StringVal: string(release.UID),
+					},
+				},
+			}))
+		})
+
+		It("should not add any param if the Release has no UID", func() {
+			builder := NewPipelineRunBuilder("testPrefix", "testNamespace").WithReleaseUID(&corev1.ConfigMap{})
+			Expect(builder.pipelineRun.Spec.Params).To(BeEmpty())
+		})
+
+		It("should not add any param if the Release is nil", func() {
+			var release *corev1.ConfigMap
+			builder := NewPipelineRunBuilder("testPrefix", "testNamespace")
+			Expect(func() { builder.WithReleaseUID(release) }).NotTo(Panic())
+			Expect(func() { builder.WithReleaseUID(nil) }).NotTo(Panic())
+			Expect(builder.pipelineRun.Spec.Params).To(BeEmpty())
+		})
+	})
+
+	When("WithRequiredParamsFromConfigMap method is called", func() {
+		var builder *PipelineRunBuilder
+
+		BeforeEach(func() {
+			builder = NewPipelineRunBuilder("testPrefix", "testNamespace")
+		})
+
+		It("should add parameters corresponding to the provided keys", func() {
+			configMap := &corev1.ConfigMap{Data: map[string]string{"key1": "value1", "key2": "value2 "}}
+
+			builder.WithRequiredParamsFromConfigMap(configMap, "key1", "key2")
+			Expect(builder.pipelineRun.Spec.Params).To(Equal(tektonv1.Params{
+				{Name: "key1", Value: tektonv1.ParamValue{Type: tektonv1.ParamTypeString, StringVal: "value1"}},
+				{Name: "key2", Value: tektonv1.ParamValue{Type: tektonv1.ParamTypeString, StringVal: "value2"}},
+			}))
+
+			_, err := builder.Build()
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("should fail to build if any of the keys is missing or empty", func() {
+			configMap := &corev1.ConfigMap{Data: map[string]string{"key1": "value1", "key2": ""}}
+
+			builder.WithRequiredParamsFromConfigMap(configMap, "key1", "key2", "key3")
+			Expect(builder.pipelineRun.Spec.Params).To(HaveLen(1))
+
+			_, err := builder.Build()
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("the key2 key is missing or empty"))
+			Expect(err.Error()).To(ContainSubstring("the key3 key is missing or empty"))
+		})
+
+		It("should not add any parameter when the ConfigMap is nil", func() {
+			builder.WithRequiredParamsFromConfigMap(nil, "key1")
+			Expect(builder.pipelineRun.Spec.Params).To(BeEmpty())
+
+			_, err := builder.Build()
+			Expect(err).NotTo(HaveOccurred())
+		})
+	})
+
+	When("WithSchedulingOptions method is called", func() {
+		It("should set the PriorityClass and scheduler names in the PodTemplate", func() {
+			builder := NewPipelineRunBuilder("testPrefix", "testNamespace")
+			builder.WithSchedulingOptions("release-critical", "custom-scheduler")
+
+			podTemplate := builder.pipelineRun.Spec.TaskRunTemplate.PodTemplate
+			Expect(*podTemplate.PriorityClassName).To(Equal("release-critical"))
+			Expect(podTemplate.SchedulerName).To(Equal("custom-scheduler"))
+		})
+
+		It("should only set the values that are not empty", func() {
+			builder := NewPipelineRunBuilder("testPrefix", "testNamespace")
+			builder.WithSchedulingOptions("", "custom-scheduler")
+
+			podTemplate := builder.pipelineRun.Spec.TaskRunTemplate.PodTemplate
+			Expect(podTemplate.PriorityClassName).To(BeNil())
+			Expect(podTemplate.SchedulerName).To(Equal("custom-scheduler"))
+		})
+
+		It("should leave the PodTemplate untouched if both values are empty", func() {
+			builder := NewPipelineRunBuilder("testPrefix", "testNamespace")
+			builder.WithSchedulingOptions("", "")
+
+			Expect(builder.pipelineRun.Spec.TaskRunTemplate.PodTemplate).To(BeNil())
+		})
+	})
+
+	When("WithSecretWorkspace method is called", func() {
+		var builder *PipelineRunBuilder
+
+		BeforeEach(func() {
+			builder = NewPipelineRunBuilder("testPrefix", "testNamespace")
+		})
+
+		It("should add a new workspace backed by the given Secret", func() {
+			builder.WithSecretWorkspace("registry-credentials", "my-secret")
+			Expect(builder.pipelineRun.Spec.Workspaces).To(HaveLen(1))
+			Expect(builder.pipelineRun.Spec.Workspaces[0].Name).To(Equal("registry-credentials"))
+			Expect(builder.pipelineRun.Spec.Workspaces[0].Secret).NotTo(BeNil())
+			Expect(builder.pipelineRun.Spec.Workspaces[0].Secret.SecretName).To(Equal("my-secret"))
+		})
+
+		It("should not add a workspace if the name or the Secret name are empty", func() {
+			builder.WithSecretWorkspace("", "my-secret").WithSecretWorkspace("registry-credentials", "")
+			Expect(builder.pipelineRun.Spec.Workspaces).To(BeEmpty())
+		})
+	})
+
+	When("WithServiceAccount method is called", func() {
+		It("should set the ServiceAccountName for the PipelineRun's TaskRunTemplate", func() {
+			builder := NewPipelineRunBuilder("testPrefix", "testNamespace")
+			serviceAccount := "sampleServiceAccount"
+			builder.WithServiceAccount(serviceAccount)
+			Expect(builder.pipelineRun.Spec.TaskRunTemplate.ServiceAccountName).To(Equal(serviceAccount))
+		})
+
+		It("should not overwrite the ServiceAccountName with an empty value", func() {
+			builder := NewPipelineRunBuilder("testPrefix", "testNamespace")
+			builder.WithServiceAccount("sampleServiceAccount").WithServiceAccount("")
+			Expect(builder.pipelineRun.Spec.TaskRunTemplate.ServiceAccountName).To(Equal("sampleServiceAccount"))
+		})
+	})
+
+	When("WithServiceAccountOrDefault method is called", func() {
+		It("should use the provided ServiceAccount", func() {
+			builder := NewPipelineRunBuilder("testPrefix", "testNamespace")
+			builder.WithServiceAccountOrDefault("pipeline-sa", "default-sa")
+			Expect(builder.pipelineRun.Spec.TaskRunTemplate.ServiceAccountName).To(Equal("pipeline-sa"))
+		})
+
+		It("should fall back to the default ServiceAccount if none is provided", func() {
+			builder := NewPipelineRunBuilder("testPrefix", "testNamespace")
+			builder.WithServiceAccountOrDefault("", "default-sa")
+			Expect(builder.pipelineRun.Spec.TaskRunTemplate.ServiceAccountName).To(Equal("default-sa"))
+
+			_, err := builder.Build()
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("should fail to build if both ServiceAccounts are empty", func() {
+			builder := NewPipelineRunBuilder("testPrefix", "testNamespace")
+			builder.WithServiceAccountOrDefault("", "")
+			Expect(builder.pipelineRun.Spec.TaskRunTemplate.ServiceAccountName).To(BeEmpty())
+
+			_, err := builder.Build()
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	When("WithSigningParams method is called", func() {
+		var builder *PipelineRunBuilder
+
+		BeforeEach(func() {
+			builder = NewPipelineRunBuilder("testPrefix", "testNamespace")
+		})
+
+		It("should add both params when both values are set", func() {
+			builder.WithSigningParams("cosign-secret", "https://rekor.example.com")
+
+			Expect(builder.pipelineRun.Spec.Params).To(Equal(tektonv1.Params{
+				{
+					Name: "cosign_key_secret",
+					Value: tektonv1.ParamValue{
+						Type:      tektonv1.ParamTypeString,
+						StringVal: "cosign-secret",
+					},
+				},
+				{
+					Name: "rekor_url",
+					Value: tektonv1.ParamValue{
+						Type:      tektonv1.ParamTypeString,
+						StringVal: "https://rekor.example.com",
+					},
+				},
+			}))
+		})
+
+		It("should only add the params whose value is set", func() {
+			builder.WithSigningParams("", "https://rekor.example.com")
+
+			Expect(builder.pipelineRun.Spec.Params).To(HaveLen(1))
+			Expect(builder.pipelineRun.Spec.Params[0].Name).To(Equal(RekorURLParamName))
+		})
+
+		It("should not add any param if both values are empty", func() {
+			builder.WithSigningParams("", "")
+
+			Expect(builder.pipelineRun.Spec.Params).To(BeEmpty())
+		})
+	})
+
+	When("WithTaskComputeResources method is called", func() {
+		var requests, limits corev1.ResourceList
+
+		BeforeEach(func() {
+			requests = corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("1Gi")}
+			limits = corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("4Gi")}
+		})
+
+		It("should add a TaskRunSpec with the compute resources for the task", func() {
+			builder := NewPipelineRunBuilder("testPrefix", "testNamespace")
+			builder.WithTaskComputeResources("build-index", requests, limits)
+
+			Expect(builder.pipelineRun.Spec.TaskRunSpecs).To(Equal([]tektonv1.PipelineTaskRunSpec{
+				{
+					PipelineTaskName: "build-index",
+					ComputeResources: &corev1.ResourceRequirements{Requests: requests, Limits: limits},
+				},
+			}))
+		})
+
+		It("should accumulate the TaskRunSpecs when called for different tasks", func() {
+			builder := NewPipelineRunBuilder("testPrefix", "testNamespace")
+			builder.WithTaskComputeResources("build-index", requests, limits).
+				WithTaskComputeResources("sign-images", nil, limits)
+
+			Expect(builder.pipelineRun.Spec.TaskRunSpecs).To(HaveLen(2))
+			Expect(builder.pipelineRun.Spec.TaskRunSpecs[0].PipelineTaskName).To(Equal("build-index"))
+			Expect(builder.pipelineRun.Spec.TaskRunSpecs[1].PipelineTaskName).To(Equal("sign-images"))
+			Expect(builder.pipelineRun.Spec.TaskRunSpecs[1].ComputeResources.Requests).To(BeNil())
+		})
+
+		It("should replace the compute resources of an existing TaskRunSpec for the task", func() {
+			builder := NewPipelineRunBuilder("testPrefix", "testNamespace")
+			builder.WithTaskRunSpecs(tektonv1.PipelineTaskRunSpec{
+				PipelineTaskName:   "build-index",
+				ServiceAccountName: "index-sa",
+			})
+			builder.WithTaskComputeResources("build-index", requests, limits)
+
+			Expect(builder.pipelineRun.Spec.TaskRunSpecs).To(HaveLen(1))
+			Expect(builder.pipelineRun.Spec.TaskRunSpecs[0].ServiceAccountName).To(Equal("index-sa"))
+			Expect(builder.pipelineRun.Spec.TaskRunSpecs[0].ComputeResources.Limits).To(Equal(limits))
+		})
+
+		It("should fail to build if the task name is empty", func() {
+			builder := NewPipelineRunBuilder("testPrefix", "testNamespace")
+			builder.WithTaskComputeResources("", requests, limits)
+
+			Expect(builder.pipelineRun.Spec.TaskRunSpecs).To(BeEmpty())
+			_, err := builder.Build()
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	When("WithTaskEnvVar method is called", func() {
+		It("should inject the env var into the given task only", func() {
+			builder := NewPipelineRunBuilder("testPrefix", "testNamespace")
+			builder.WithTaskRunSpecs(tektonv1.PipelineTaskRunSpec{
+				PipelineTaskName:   "build-index",
+				ServiceAccountName: "index-sa",
+			})
+			builder.WithTaskEnvVar("verify-enterprise-contract", "HTTPS_PROXY", "http://proxy:3128")
+
+			Expect(builder.pipelineRun.Spec.TaskRunSpecs).To(HaveLen(2))
+			Expect(builder.pipelineRun.Spec.TaskRunSpecs[0].PodTemplate).To(BeNil())
+			Expect(builder.pipelineRun.Spec.TaskRunSpecs[1].PipelineTaskName).To(Equal("verify-enterprise-contract"))
+			Expect(builder.pipelineRun.Spec.TaskRunSpecs[1].PodTemplate.Env).To(Equal([]corev1.EnvVar{
+				{Name: "HTTPS_PROXY", Value: "http://proxy:3128"},
+			}))
+		})
+
+		It("should accumulate the env vars of repeated calls for the same task", func() {
+			builder := NewPipelineRunBuilder("testPrefix", "testNamespace")
+			builder.WithTaskEnvVar("verify-enterprise-contract", "HTTPS_PROXY", "http://proxy:3128").
+				WithTaskEnvVar("verify-enterprise-contract", "NO_PROXY", "localhost").
+				WithTaskEnvVar("verify-enterprise-contract", "HTTPS_PROXY", "http://other-proxy:3128")
+
+			Expect(builder.pipelineRun.Spec.TaskRunSpecs).To(HaveLen(1))
+			Expect(builder.pipelineRun.Spec.TaskRunSpecs[0].PodTemplate.Env).To(Equal([]corev1.EnvVar{
+				{Name: "HTTPS_PROXY", Value: "http://other-proxy:3128"},
+				{Name: "NO_PROXY", Value: "localhost"},
+			}))
+		})
+
+		It("should fail to build if the task name is empty", func() {
+			builder := NewPipelineRunBuilder("testPrefix", "testNamespace")
+			builder.WithTaskEnvVar("", "HTTPS_PROXY", "http://proxy:3128")
+
+			Expect(builder.pipelineRun.Spec.TaskRunSpecs).To(BeEmpty())
+			_, err := builder.Build()
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	When("WithTaskMatrix method is called", func() {
+		var (
+			builder      *PipelineRunBuilder
+			matrixParams []tektonv1.Param
+		)
+
+		BeforeEach(func() {
+			builder = NewPipelineRunBuilder("testPrefix", "testNamespace").
+				WithPipelineSpec(&tektonv1.PipelineSpec{
+					Tasks:   []tektonv1.PipelineTask{{Name: "push"}},
+					Finally: []tektonv1.PipelineTask{{Name: "notify"}},
+				})
+			matrixParams = []tektonv1.Param{
+				{Name: "registry", Value: *tektonv1.NewStructuredValues("quay.io", "registry.redhat.io")},
+			}
+		})
+
+		It("should attach the matrix params to the named task", func() {
+			builder.WithTaskMatrix("push", matrixParams...)
+			Expect(builder.err).NotTo(HaveOccurred())
+			Expect(builder.pipelineRun.Spec.PipelineSpec.Tasks[0].Matrix).To(Equal(&tektonv1.Matrix{
+				Params: tektonv1.Params{
+					{Name: "registry", Value: *tektonv1.NewStructuredValues("quay.io", "registry.redhat.io")},
+				},
+			}))
+		})
+
+		It("should attach the matrix params to finally tasks", func() {
+			builder.WithTaskMatrix("notify", matrixParams...)
+			Expect(builder.err).NotTo(HaveOccurred())
+			Expect(builder.pipelineRun.Spec.PipelineSpec.Finally[0].Matrix.Params).To(HaveLen(1))
+		})
+
+		It("should fail if no PipelineSpec is embedded", func() {
+			builder = NewPipelineRunBuilder("testPrefix", "testNamespace").WithTaskMatrix("push", matrixParams...)
+			Expect(builder.err).To(HaveOccurred())
+			Expect(builder.err.Error()).To(ContainSubstring("a PipelineSpec must be embedded to set a matrix on task push"))
+		})
+
+		It("should fail if the task is not defined in the PipelineSpec", func() {
+			builder.WithTaskMatrix("sign", matrixParams...)
+			Expect(builder.err).To(HaveOccurred())
+			Expect(builder.err.Error()).To(ContainSubstring("task sign is not defined in the PipelineSpec"))
+		})
+
+		It("should fail if a matrix param is not an array", func() {
+			builder.WithTaskMatrix("push", tektonv1.Param{Name: "registry", Value: *tektonv1.NewStructuredValues("quay.io")})
+			Expect(builder.err).To(HaveOccurred())
+			Expect(builder.err.Error()).To(ContainSubstring("matrix param registry of task push is not an array"))
+			Expect(builder.pipelineRun.Spec.PipelineSpec.Tasks[0].Matrix).To(BeNil())
+		})
+	})
+
+	When("WithTaskRunSpecs method is called", func() {
 		It("should set the TaskRunSpecs for the PipelineRun's spec", func() {
 			builder := NewPipelineRunBuilder("testPrefix", "testNamespace")
 			taskRunSpecs := []tektonv1.PipelineTaskRunSpec{
@@ -436,24 +1923,209 @@ var _ = Describe("PipelineRun builder", func() {
 			builder.WithTaskRunSpecs()
 			Expect(builder.pipelineRun.Spec.TaskRunSpecs).To(BeEmpty())
 		})
+
+		It("should skip TaskRunSpecs without a PipelineTaskName", func() {
+			builder := NewPipelineRunBuilder("testPrefix", "testNamespace")
+			taskRunSpec := tektonv1.PipelineTaskRunSpec{
+				PipelineTaskName:   "sign-images",
+				ServiceAccountName: "signing-sa",
+				ComputeResources: &corev1.ResourceRequirements{
+					Limits: corev1.ResourceList{
+						corev1.ResourceMemory: resource.MustParse("4Gi"),
+					},
+				},
+			}
+			builder.WithTaskRunSpecs(
+				tektonv1.PipelineTaskRunSpec{
+					ComputeResources: &corev1.ResourceRequirements{
+						Limits: corev1.ResourceList{
+							corev1.ResourceMemory: resource.MustParse("1Gi"),
+						},
+					},
+				},
+				taskRunSpec,
+			)
+			Expect(builder.pipelineRun.Spec.TaskRunSpecs).To(Equal([]tektonv1.PipelineTaskRunSpec{taskRunSpec}))
+		})
+
+		It("should replace the TaskRunSpecs set by previous calls", func() {
+			builder := NewPipelineRunBuilder("testPrefix", "testNamespace")
+			limits := corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("1Gi")}
+			taskRunSpec := tektonv1.PipelineTaskRunSpec{
+				PipelineTaskName: "sign-images",
+				ComputeResources: &corev1.ResourceRequirements{
+					Limits: corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("4Gi")},
+				},
+			}
+			builder.WithTaskComputeResources("build-index", nil, limits).
+				WithTaskComputeResources("sign-images", nil, limits).
+				WithTaskRunSpecs(taskRunSpec)
+			Expect(builder.pipelineRun.Spec.TaskRunSpecs).To(Equal([]tektonv1.PipelineTaskRunSpec{taskRunSpec}))
+		})
+
+		It("should keep the compute resources of the TaskRunSpecs unless they are set afterwards", func() {
+			builder := NewPipelineRunBuilder("testPrefix", "testNamespace")
+			limits := corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("1Gi")}
+			builder.WithTaskRunSpecs(
+				tektonv1.PipelineTaskRunSpec{
+					PipelineTaskName: "build-index",
+					ComputeResources: &corev1.ResourceRequirements{
+						Limits: corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("4Gi")},
+					},
+				},
+				tektonv1.PipelineTaskRunSpec{
+					PipelineTaskName: "sign-images",
+					ComputeResources: &corev1.ResourceRequirements{
+						Limits: corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("4Gi")},
+					},
+				},
+			).WithTaskComputeResources("sign-images", nil, limits)
+			Expect(builder.pipelineRun.Spec.TaskRunSpecs[0].ComputeResources.Limits.Memory().String()).To(Equal("4Gi"))
+			Expect(builder.pipelineRun.Spec.TaskRunSpecs[1].ComputeResources.Limits).To(Equal(limits))
+		})
+	})
+
+	When("WithTaskServiceAccount method is called", func() {
+		It("should override the ServiceAccount of the task while keeping the PipelineRun one", func() {
+			builder := NewPipelineRunBuilder("testPrefix", "testNamespace").
+				WithServiceAccount("release-service-account").
+				WithTaskServiceAccount("verify-conforma", "verify-service-account")
+
+			Expect(builder.err).NotTo(HaveOccurred())
+			Expect(builder.pipelineRun.Spec.TaskRunTemplate.ServiceAccountName).To(Equal("release-service-account"))
+			Expect(builder.pipelineRun.Spec.TaskRunSpecs).To(Equal([]tektonv1.PipelineTaskRunSpec{
+				{
+					PipelineTaskName:   "verify-conforma",
+					ServiceAccountName: "verify-service-account",
+				},
+			}))
+		})
+
+		It("should update the existing TaskRunSpec of the task", func() {
+			requests := corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("100m")}
+			builder := NewPipelineRunBuilder("testPrefix", "testNamespace").
+				WithTaskComputeResources("verify-conforma", requests, nil).
+				WithTaskServiceAccount("verify-conforma", "verify-service-account")
+
+			Expect(builder.pipelineRun.Spec.TaskRunSpecs).To(HaveLen(1))
+			Expect(builder.pipelineRun.Spec.TaskRunSpecs[0].ServiceAccountName).To(Equal("verify-service-account"))
+			Expect(builder.pipelineRun.Spec.TaskRunSpecs[0].ComputeResources.Requests).To(Equal(requests))
+		})
+
+		It("should fail if the task name or the ServiceAccount is empty", func() {
+			builder := NewPipelineRunBuilder("testPrefix", "testNamespace").
+				WithTaskServiceAccount("", "verify-service-account").
+				WithTaskServiceAccount("verify-conforma", "")
+
+			Expect(builder.err).To(HaveOccurred())
+			Expect(builder.err.Error()).To(ContainSubstring("a task name and a service account are required"))
+			Expect(builder.pipelineRun.Spec.TaskRunSpecs).To(BeEmpty())
+		})
+	})
+
+	When("WithTimeoutDurations method is called", func() {
+		It("should map each duration to its timeout field", func() {
+			builder := NewPipelineRunBuilder("testPrefix", "testNamespace")
+			builder.WithTimeoutDurations(3*time.Hour, 2*time.Hour, 1*time.Hour)
+			Expect(builder.pipelineRun.Spec.Timeouts).To(Equal(&tektonv1.TimeoutFields{
+				Pipeline: &metav1.Duration{Duration: 3 * time.Hour},
+				Tasks:    &metav1.Duration{Duration: 2 * time.Hour},
+				Finally:  &metav1.Duration{Duration: 1 * time.Hour},
+			}))
+		})
+
+		It("should omit the timeout fields with zero durations", func() {
+			builder := NewPipelineRunBuilder("testPrefix", "testNamespace")
+			builder.WithTimeoutDurations(3*time.Hour, 0, 1*time.Hour)
+			Expect(builder.pipelineRun.Spec.Timeouts.Pipeline.Duration).To(Equal(3 * time.Hour))
+			Expect(builder.pipelineRun.Spec.Timeouts.Tasks).To(BeNil())
+			Expect(builder.pipelineRun.Spec.Timeouts.Finally.Duration).To(Equal(1 * time.Hour))
+		})
+
+		It("should not set the timeouts if all the durations are zero", func() {
+			builder := NewPipelineRunBuilder("testPrefix", "testNamespace")
+			builder.WithTimeoutDurations(0, 0, 0)
+			Expect(builder.pipelineRun.Spec.Timeouts).To(BeNil())
+		})
+
+		It("should fail if the tasks and finally durations exceed the pipeline duration", func() {
+			builder := NewPipelineRunBuilder("testPrefix", "testNamespace")
+			builder.WithTimeoutDurations(1*time.Hour, 1*time.Hour, 1*time.Hour)
+			Expect(builder.err).To(HaveOccurred())
+		})
 	})
 
 	When("WithTimeouts method is called", func() {
 		It("should set the timeouts for the PipelineRun", func() {
 			builder := NewPipelineRunBuilder("testPrefix", "testNamespace")
 			timeouts := &tektonv1.TimeoutFields{
-				Pipeline: &metav1.Duration{Duration: 1 * time.Hour},
+				Pipeline: &metav1.Duration{Duration: 2 * time.Hour},
 				Tasks:    &metav1.Duration{Duration: 1 * time.Hour},
 				Finally:  &metav1.Duration{Duration: 1 * time.Hour},
 			}
 			builder.WithTimeouts(timeouts, nil)
 			Expect(builder.pipelineRun.Spec.Timeouts).To(Equal(timeouts))
+			Expect(builder.err).To(BeNil())
+		})
+
+		It("should accept the timeouts if only some of the sections are set", func() {
+			builder := NewPipelineRunBuilder("testPrefix", "testNamespace")
+			builder.WithTimeouts(&tektonv1.TimeoutFields{
+				Pipeline: &metav1.Duration{Duration: 1 * time.Hour},
+				Finally:  &metav1.Duration{Duration: 1 * time.Hour},
+			}, nil)
+			Expect(builder.err).To(BeNil())
+
+			builder.WithTimeouts(&tektonv1.TimeoutFields{
+				Tasks:   &metav1.Duration{Duration: 2 * time.Hour},
+				Finally: &metav1.Duration{Duration: 1 * time.Hour},
+			}, nil)
+			Expect(builder.err).To(BeNil())
+		})
+
+		It("should accept section timeouts exceeding a zero pipeline timeout", func() {
+			builder := NewPipelineRunBuilder("testPrefix", "testNamespace")
+			builder.WithTimeouts(&tektonv1.TimeoutFields{
+				Pipeline: &metav1.Duration{Duration: 0},
+				Tasks:    &metav1.Duration{Duration: 1 * time.Hour},
+			}, nil)
+			Expect(builder.err).To(BeNil())
+		})
+
+		It("should fail if the tasks timeout exceeds the pipeline timeout", func() {
+			builder := NewPipelineRunBuilder("testPrefix", "testNamespace")
+			builder.WithTimeouts(&tektonv1.TimeoutFields{
+				Pipeline: &metav1.Duration{Duration: 1 * time.Hour},
+				Tasks:    &metav1.Duration{Duration: 2 * time.Hour},
+			}, nil)
+			Expect(builder.err).To(HaveOccurred())
+			Expect(builder.err.Error()).To(ContainSubstring("exceed the pipeline timeout"))
+		})
+
+		It("should fail if the tasks and finally timeouts exceed the pipeline timeout", func() {
+			builder := NewPipelineRunBuilder("testPrefix", "testNamespace")
+			builder.WithTimeouts(&tektonv1.TimeoutFields{
+				Pipeline: &metav1.Duration{Duration: 2 * time.Hour},
+				Tasks:    &metav1.Duration{Duration: 1 * time.Hour},
+				Finally:  &metav1.Duration{Duration: 90 * time.Minute},
+			}, nil)
+			Expect(builder.err).To(HaveOccurred())
+			Expect(builder.err.Error()).To(ContainSubstring("exceed the pipeline timeout"))
+		})
+
+		It("should validate the default timeouts when they are used", func() {
+			builder := NewPipelineRunBuilder("testPrefix", "testNamespace")
+			builder.WithTimeouts(nil, &tektonv1.TimeoutFields{
+				Pipeline: &metav1.Duration{Duration: 1 * time.Hour},
+				Finally:  &metav1.Duration{Duration: 2 * time.Hour},
+			})
+			Expect(builder.err).To(HaveOccurred())
 		})
 
 		It("should use the default timeouts if the given timeouts are empty", func() {
 			builder := NewPipelineRunBuilder("testPrefix", "testNamespace")
 			defaultTimeouts := &tektonv1.TimeoutFields{
-				Pipeline: &metav1.Duration{Duration: 1 * time.Hour},
+				Pipeline: &metav1.Duration{Duration: 2 * time.Hour},
 				Tasks:    &metav1.Duration{Duration: 1 * time.Hour},
 				Finally:  &metav1.Duration{Duration: 1 * time.Hour},
 			}
@@ -462,6 +2134,192 @@ var _ = Describe("PipelineRun builder", func() {
 		})
 	})
 
+	When("WithTolerations method is called", func() {
+		It("should initialize the PodTemplate and set the tolerations", func() {
+			builder := NewPipelineRunBuilder("testPrefix", "testNamespace")
+			tolerations := []corev1.Toleration{{Key: "dedicated", Operator: corev1.TolerationOpExists}}
+			builder.WithTolerations(tolerations)
+			Expect(builder.pipelineRun.Spec.TaskRunTemplate.PodTemplate).NotTo(BeNil())
+			Expect(builder.pipelineRun.Spec.TaskRunTemplate.PodTemplate.Tolerations).To(Equal(tolerations))
+		})
+
+		It("should append the tolerations to the existing ones", func() {
+			builder := NewPipelineRunBuilder("testPrefix", "testNamespace")
+			builder.WithTolerations([]corev1.Toleration{{Key: "dedicated", Operator: corev1.TolerationOpExists}})
+			builder.WithTolerations([]corev1.Toleration{{Key: "gpu", Operator: corev1.TolerationOpExists}})
+			Expect(builder.pipelineRun.Spec.TaskRunTemplate.PodTemplate.Tolerations).To(HaveLen(2))
+		})
+
+		It("should not overwrite the node selector", func() {
+			builder := NewPipelineRunBuilder("testPrefix", "testNamespace")
+			builder.WithNodeSelector(map[string]string{"node-role": "release"}).
+				WithTolerations([]corev1.Toleration{{Key: "dedicated", Operator: corev1.TolerationOpExists}})
+			Expect(builder.pipelineRun.Spec.TaskRunTemplate.PodTemplate.NodeSelector).To(Equal(map[string]string{"node-role": "release"}))
+			Expect(builder.pipelineRun.Spec.TaskRunTemplate.PodTemplate.Tolerations).To(HaveLen(1))
+		})
+
+		It("should leave the PodTemplate unset when no tolerations are passed", func() {
+			builder := NewPipelineRunBuilder("testPrefix", "testNamespace")
+			builder.WithTolerations(nil)
+			Expect(builder.pipelineRun.Spec.TaskRunTemplate.PodTemplate).To(BeNil())
+		})
+	})
+
+	When("WithTraceContext method is called", func() {
+		var builder *PipelineRunBuilder
+
+		BeforeEach(func() {
+			builder = NewPipelineRunBuilder("testPrefix", "testNamespace")
+		})
+
+		It("should add the trace context of the active span as annotations", func() {
+			traceState, err := trace.ParseTraceState("vendor=value")
+			Expect(err).NotTo(HaveOccurred())
+
+			ctx := trace.ContextWithSpanContext(context.Background(), trace.NewSpanContext(trace.SpanContextConfig{
+				TraceID:    trace.TraceID{0x0a, 0xf7, 0x65, 0x19, 0x16, 0xcd, 0x43, 0xdd, 0x84, 0x48, 0xeb, 0x21, 0x1c, 0x80, 0x31, 0x9c},
+				SpanID:     trace.SpanID{0xb7, 0xad, 0x6b, 0x71, 0x69, 0x20, 0x33, 0x31},
+				TraceFlags: trace.FlagsSampled,
+				TraceState: traceState,
+			}))
+			builder.WithTraceContext(ctx)
+
+			Expect(builder.pipelineRun.Annotations).To(Equal(map[string]string{
+				metadata.TraceContextAnnotationPrefix + "traceparent": "00-0af7651916cd43dd8448eb211c80319c-b7ad6b7169203331-01",
+				metadata.TraceContextAnnotationPrefix + "tracestate":  "vendor=value",
+			}))
+		})
+
+		It("should not add any annotation if there is no active span", func() {
+			builder.WithTraceContext(context.Background())
+			Expect(builder.pipelineRun.Annotations).To(BeEmpty())
+		})
+	})
+
+	When("WithTypedObjectReferences method is called", func() {
+		var snapshot *applicationapiv1alpha1.Snapshot
+
+		BeforeEach(func() {
+			snapshot = &applicationapiv1alpha1.Snapshot{
+				ObjectMeta: metav1.ObjectMeta{Name: "snapshot", Namespace: "namespace"},
+			}
+			snapshot.SetGroupVersionKind(applicationapiv1alpha1.GroupVersion.WithKind("Snapshot"))
+		})
+
+		It("should use the given name for the param", func() {
+			builder := NewPipelineRunBuilder("testPrefix", "testNamespace")
+			builder.WithTypedObjectReferences(ObjectReference{Name: "mySnapshot", Object: snapshot})
+
+			Expect(builder.pipelineRun.Spec.Params).To(Equal(tektonv1.Params{{
+				Name:  "mySnapshot",
+				Value: tektonv1.ParamValue{Type: tektonv1.ParamTypeString, StringVal: "namespace/snapshot"},
+			}}))
+		})
+
+		It("should derive the param name from the object's group and kind when no name is given", func() {
+			builder := NewPipelineRunBuilder("testPrefix", "testNamespace")
+			configMap := &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "configName", Namespace: "configNamespace"}}
+			configMap.Kind = "ConfigMap"
+
+			builder.WithTypedObjectReferences(ObjectReference{Object: snapshot}, ObjectReference{Object: configMap})
+
+			Expect(builder.pipelineRun.Spec.Params).To(Equal(tektonv1.Params{
+				{
+					Name:  "appstudio.redhat.com-snapshot",
+					Value: tektonv1.ParamValue{Type: tektonv1.ParamTypeString, StringVal: "namespace/snapshot"},
+				},
+				{
+					Name:  "configmap",
+					Value: tektonv1.ParamValue{Type: tektonv1.ParamTypeString, StringVal: "configNamespace/configName"},
+				},
+			}))
+			_, err := builder.Build()
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("should fail to build if two references use the same name", func() {
+			builder := NewPipelineRunBuilder("testPrefix", "testNamespace")
+			builder.WithTypedObjectReferences(
+				ObjectReference{Object: snapshot},
+				ObjectReference{Name: "appstudio.redhat.com-snapshot", Object: snapshot},
+			)
+
+			Expect(builder.pipelineRun.Spec.Params).To(HaveLen(1))
+			_, err := builder.Build()
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("already used by another object reference"))
+		})
+
+		It("should fail to build if a reference collides with one added by WithObjectReferences", func() {
+			builder := NewPipelineRunBuilder("testPrefix", "testNamespace")
+			builder.WithObjectReferences(snapshot).
+				WithTypedObjectReferences(ObjectReference{Name: "snapshot", Object: snapshot})
+
+			_, err := builder.Build()
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	When("WithWorkspaceFromPersistentVolumeClaim method is called", func() {
+		var builder *PipelineRunBuilder
+
+		BeforeEach(func() {
+			builder = NewPipelineRunBuilder("testPrefix", "testNamespace")
+		})
+
+		It("should add a new workspace binding using the given PersistentVolumeClaim and subPath", func() {
+			builder.WithWorkspaceFromPersistentVolumeClaim("sampleWorkspace", "release-pvc", "6f1c7c0e-run")
+			Expect(builder.pipelineRun.Spec.Workspaces).To(Equal([]tektonv1.WorkspaceBinding{
+				{
+					Name:                  "sampleWorkspace",
+					PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{ClaimName: "release-pvc"},
+					SubPath:               "6f1c7c0e-run",
+				},
+			}))
+		})
+
+		It("should sanitize the subPath", func() {
+			builder.WithWorkspaceFromPersistentVolumeClaim("sampleWorkspace", "release-pvc", "../namespace/release name")
+			Expect(builder.pipelineRun.Spec.Workspaces[0].SubPath).To(Equal("namespace-release-name"))
+		})
+
+		It("should leave the subPath empty if it only contains invalid characters", func() {
+			builder.WithWorkspaceFromPersistentVolumeClaim("sampleWorkspace", "release-pvc", "../")
+			Expect(builder.pipelineRun.Spec.Workspaces[0].SubPath).To(BeEmpty())
+		})
+
+		It("should not add a workspace binding if the name is empty", func() {
+			Expect(builder.WithWorkspaceFromPersistentVolumeClaim("", "release-pvc", "")).To(Equal(builder))
+			Expect(builder.pipelineRun.Spec.Workspaces).To(BeEmpty())
+		})
+	})
+
+	When("WithWorkspaceFromVolumeClaimTemplate method is called", func() {
+		var builder *PipelineRunBuilder
+
+		BeforeEach(func() {
+			builder = NewPipelineRunBuilder("testPrefix", "testNamespace")
+		})
+
+		It("should add a new workspace binding using the given VolumeClaimTemplate", func() {
+			template := &corev1.PersistentVolumeClaim{
+				Spec: corev1.PersistentVolumeClaimSpec{
+					AccessModes: []corev1.PersistentVolumeAccessMode{corev1.ReadWriteMany},
+				},
+			}
+			builder.WithWorkspaceFromVolumeClaimTemplate("sampleWorkspace", template)
+			Expect(builder.pipelineRun.Spec.Workspaces).To(HaveLen(1))
+			Expect(builder.pipelineRun.Spec.Workspaces[0].Name).To(Equal("sampleWorkspace"))
+			Expect(builder.pipelineRun.Spec.Workspaces[0].VolumeClaimTemplate).To(Equal(template))
+			Expect(builder.pipelineRun.Spec.Workspaces[0].SubPath).To(BeEmpty())
+		})
+
+		It("should not add a workspace binding if the name is empty", func() {
+			Expect(builder.WithWorkspaceFromVolumeClaimTemplate("", &corev1.PersistentVolumeClaim{})).To(Equal(builder))
+			Expect(builder.pipelineRun.Spec.Workspaces).To(BeEmpty())
+		})
+	})
+
 	When("WithWorkspaceFromVolumeTemplate method is called", func() {
 		var (
 			builder *PipelineRunBuilder
@@ -475,20 +2333,87 @@ var _ = Describe("PipelineRun builder", func() {
 
 		It("should add a new workspace binding to the PipelineRun's spec with the correct name and size", func() {
 			size := "5Gi"
-			builder.WithWorkspaceFromVolumeTemplate(name, size)
+			builder.WithWorkspaceFromVolumeTemplate(name, size, "")
 			Expect(len(builder.pipelineRun.Spec.Workspaces)).To(Equal(1))
 
 			workspaceBinding := builder.pipelineRun.Spec.Workspaces[0]
 			Expect(workspaceBinding.Name).To(Equal(name))
 			workspaceQuantity := workspaceBinding.VolumeClaimTemplate.Spec.Resources.Requests[corev1.ResourceStorage]
 			Expect(workspaceQuantity.String()).To(Equal(size))
+			Expect(workspaceBinding.VolumeClaimTemplate.Spec.StorageClassName).To(BeNil())
+		})
+
+		It("should set the storage class if one is provided", func() {
+			builder.WithWorkspaceFromVolumeTemplate(name, "5Gi", "fast")
+			Expect(builder.pipelineRun.Spec.Workspaces).To(HaveLen(1))
+			Expect(*builder.pipelineRun.Spec.Workspaces[0].VolumeClaimTemplate.Spec.StorageClassName).To(Equal("fast"))
 		})
 
 		It("should fail if the size is not in the right format", func() {
-			builder.WithWorkspaceFromVolumeTemplate(name, "invalid")
+			builder.WithWorkspaceFromVolumeTemplate(name, "invalid", "")
 			_, err := builder.Build()
 			Expect(err).To(HaveOccurred())
 			Expect(err.Error()).To(ContainSubstring("invalid size format"))
 		})
 	})
+
+	When("WithWorkspaces method is called", func() {
+		var builder *PipelineRunBuilder
+
+		BeforeEach(func() {
+			builder = NewPipelineRunBuilder("testPrefix", "testNamespace")
+		})
+
+		It("should bind each workspace using its source", func() {
+			builder.WithWorkspaces(
+				Workspace{Name: "config", ConfigMap: "my-config"},
+				Workspace{Name: "scratch", EmptyDir: true},
+				Workspace{Name: "cache", PersistentVolumeClaim: "my-claim"},
+				Workspace{Name: "keys", Secret: "my-secret"},
+			)
+
+			workspaces := builder.pipelineRun.Spec.Workspaces
+			Expect(workspaces).To(HaveLen(4))
+			Expect(workspaces[0].ConfigMap.Name).To(Equal("my-config"))
+			Expect(workspaces[1].EmptyDir).NotTo(BeNil())
+			Expect(workspaces[2].PersistentVolumeClaim.ClaimName).To(Equal("my-claim"))
+			Expect(workspaces[3].Secret.SecretName).To(Equal("my-secret"))
+		})
+
+		It("should fail to build if a workspace name is already bound", func() {
+			builder.WithEmptyDirWorkspace("release-workspace").
+				WithWorkspaces(Workspace{Name: "release-workspace", Secret: "my-secret"})
+
+			Expect(builder.pipelineRun.Spec.Workspaces).To(HaveLen(1))
+			_, err := builder.Build()
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("a workspace named release-workspace is already bound"))
+		})
+
+		It("should fail to build if a workspace doesn't set exactly one source", func() {
+			builder.WithWorkspaces(
+				Workspace{Name: "none"},
+				Workspace{Name: "both", ConfigMap: "my-config", Secret: "my-secret"},
+			)
+
+			Expect(builder.pipelineRun.Spec.Workspaces).To(BeEmpty())
+			_, err := builder.Build()
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("the none workspace must set exactly one source"))
+			Expect(err.Error()).To(ContainSubstring("the both workspace must set exactly one source"))
+		})
+	})
 })
+
+// unserializableObject is a client.Object whose Spec can't be serialized to JSON.
+type unserializableObject struct {
+	metav1.TypeMeta
+	metav1.ObjectMeta
+	Spec struct {
+		Channel chan int
+	}
+}
+
+func (o *unserializableObject) DeepCopyObject() runtime.Object {
+	return o
+}
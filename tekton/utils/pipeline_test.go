@@ -29,6 +29,7 @@ var _ = Describe("Pipeline", func() {
 	var (
 		clusterRef PipelineRef
 		gitRef     PipelineRef
+		hubRef     PipelineRef
 		bundleRef  PipelineRef
 	)
 
@@ -49,6 +50,15 @@ var _ = Describe("Pipeline", func() {
 				{Name: "pathInRepo", Value: "my-path-in-repo"},
 			},
 		}
+		hubRef = PipelineRef{
+			Resolver: "hub",
+			Params: []Param{
+				{Name: "catalog", Value: "my-catalog"},
+				{Name: "kind", Value: "pipeline"},
+				{Name: "name", Value: "my-hub-pipeline"},
+				{Name: "version", Value: "0.1"},
+			},
+		}
 		bundleRef = PipelineRef{
 			Resolver: "bundles",
 			Params: []Param{
@@ -59,6 +69,41 @@ var _ = Describe("Pipeline", func() {
 		}
 	})
 
+	When("NewClusterPipelineRef function is called", func() {
+		It("should return a PipelineRef using the cluster resolver", func() {
+			ref := NewClusterPipelineRef("my-namespace", "my-cluster-pipeline")
+			Expect(*ref).To(Equal(clusterRef))
+			Expect(ref.IsClusterScoped()).To(BeTrue())
+		})
+
+		It("should return a valid PipelineRef", func() {
+			Expect(NewClusterPipelineRef("my-namespace", "my-cluster-pipeline").Validate()).To(Succeed())
+		})
+	})
+
+	When("NewGitPipelineRef function is called", func() {
+		It("should return a PipelineRef using the git resolver", func() {
+			ref := NewGitPipelineRef("my-git-url", "my-revision", "my-path-in-repo")
+			Expect(*ref).To(Equal(gitRef))
+		})
+
+		It("should return a PipelineRef which parameters can be read back", func() {
+			url, revision, pathInRepo, err := NewGitPipelineRef("url", "revision", "path").GetGitResolverParams()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(url).To(Equal("url"))
+			Expect(revision).To(Equal("revision"))
+			Expect(pathInRepo).To(Equal("path"))
+		})
+	})
+
+	When("NewHubPipelineRef function is called", func() {
+		It("should return a valid PipelineRef using the hub resolver", func() {
+			ref := NewHubPipelineRef("my-catalog", "my-hub-pipeline", "0.1")
+			Expect(*ref).To(Equal(hubRef))
+			Expect(ref.Validate()).To(Succeed())
+		})
+	})
+
 	When("GetGitResolverParams method is called", func() {
 		It("should return all the common parameters", func() {
 			url, revision, pathInRepo, err := gitRef.GetGitResolverParams()
@@ -137,6 +182,125 @@ var _ = Describe("Pipeline", func() {
 		})
 	})
 
+	When("Validate method is called", func() {
+		It("should succeed for valid PipelineRefs", func() {
+			Expect(clusterRef.Validate()).To(Succeed())
+			Expect(gitRef.Validate()).To(Succeed())
+			Expect(bundleRef.Validate()).To(Succeed())
+			Expect(hubRef.Validate()).To(Succeed())
+		})
+
+		It("should succeed if a git PipelineRef relies on the default revision", func() {
+			gitRef.Params = []Param{
+				{Name: "url", Value: "my-git-url"},
+				{Name: "pathInRepo", Value: "my-path"},
+			}
+			Expect(gitRef.Validate()).To(Succeed())
+		})
+
+		It("should succeed if a git PipelineRef uses the SCM API mode", func() {
+			gitRef.Params = []Param{
+				{Name: "repo", Value: "my-repo"},
+				{Name: "org", Value: "my-org"},
+				{Name: "revision", Value: "my-revision"},
+				{Name: "pathInRepo", Value: "my-path"},
+			}
+			Expect(gitRef.Validate()).To(Succeed())
+		})
+
+		It("should fail if a git PipelineRef defines a bundle", func() {
+			gitRef.Params = append(gitRef.Params, Param{Name: "bundle", Value: "my-bundle"})
+			err := gitRef.Validate()
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("can't define a bundle param"))
+		})
+
+		It("should fail if a bundles PipelineRef defines git params", func() {
+			bundleRef.Params = append(bundleRef.Params, Param{Name: "url", Value: "my-git-url"})
+			err := bundleRef.Validate()
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("can't define the git url param"))
+		})
+
+		It("should fail if a cluster PipelineRef misses its namespace", func() {
+			clusterRef.Params = clusterRef.Params[:2]
+			err := clusterRef.Validate()
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("requires the namespace param"))
+		})
+
+		It("should fail if a cluster PipelineRef defines git or bundle params", func() {
+			clusterRef.Params = append(clusterRef.Params, Param{Name: "bundle", Value: "my-bundle"})
+			err := clusterRef.Validate()
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("can't define the bundle param"))
+		})
+
+		It("should fail if a hub PipelineRef misses its version", func() {
+			hubRef.Params = hubRef.Params[:3]
+			err := hubRef.Validate()
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("requires the version param"))
+		})
+
+		It("should fail if a hub PipelineRef defines a bundle", func() {
+			hubRef.Params = append(hubRef.Params, Param{Name: "bundle", Value: "my-bundle"})
+			err := hubRef.Validate()
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("can't define the bundle param"))
+		})
+
+		It("should fail if a hub PipelineRef defines git params", func() {
+			hubRef.Params = append(hubRef.Params, Param{Name: "url", Value: "my-git-url"})
+			err := hubRef.Validate()
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("can't define the url param"))
+		})
+
+		It("should fail if a cluster PipelineRef references something other than a pipeline", func() {
+			clusterRef.Params[0].Value = "task"
+			err := clusterRef.Validate()
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("can only reference a pipeline"))
+		})
+	})
+
+	When("ValidateUnambiguous method is called", func() {
+		It("should succeed for PipelineRefs using only the params of their resolver", func() {
+			Expect(clusterRef.ValidateUnambiguous()).To(Succeed())
+			Expect(gitRef.ValidateUnambiguous()).To(Succeed())
+			Expect(bundleRef.ValidateUnambiguous()).To(Succeed())
+			Expect(hubRef.ValidateUnambiguous()).To(Succeed())
+		})
+
+		It("should succeed if a PipelineRef misses the params required by its resolver", func() {
+			hubRef.Params = hubRef.Params[:1]
+			Expect(hubRef.ValidateUnambiguous()).To(Succeed())
+			Expect(hubRef.Validate()).NotTo(Succeed())
+		})
+
+		It("should fail if a git PipelineRef defines a bundle", func() {
+			gitRef.Params = append(gitRef.Params, Param{Name: "bundle", Value: "my-bundle"})
+			err := gitRef.ValidateUnambiguous()
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("can't define a bundle param"))
+		})
+
+		It("should fail if a bundles PipelineRef defines git params", func() {
+			bundleRef.Params = append(bundleRef.Params, Param{Name: "revision", Value: "main"})
+			err := bundleRef.ValidateUnambiguous()
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("can't define the git revision param"))
+		})
+
+		It("should fail if a cluster PipelineRef defines a bundle", func() {
+			clusterRef.Params = append(clusterRef.Params, Param{Name: "bundle", Value: "my-bundle"})
+			err := clusterRef.ValidateUnambiguous()
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("can't define the bundle param"))
+		})
+	})
+
 	When("GetTektonParams method is called", func() {
 		It("should return a tekton Param list", func() {
 			parameterizedPipeline := ParameterizedPipeline{}
@@ -156,6 +320,109 @@ var _ = Describe("Pipeline", func() {
 		})
 	})
 
+	When("toTektonParam function is called", func() {
+		It("should return a string tekton Param", func() {
+			param := toTektonParam(Param{Name: "parameter", Value: "value"})
+			Expect(param).To(Equal(tektonv1.Param{
+				Name: "parameter",
+				Value: tektonv1.ParamValue{
+					Type:      tektonv1.ParamTypeString,
+					StringVal: "value",
+				},
+			}))
+		})
+
+		It("should return an object tekton Param if objectValues is set", func() {
+			param := toTektonParam(Param{Name: "parameter", ObjectValues: map[string]string{"key": "value"}})
+			Expect(param).To(Equal(tektonv1.Param{
+				Name: "parameter",
+				Value: tektonv1.ParamValue{
+					Type:      tektonv1.ParamTypeObject,
+					ObjectVal: map[string]string{"key": "value"},
+				},
+			}))
+		})
+
+		It("should classify every param independently", func() {
+			stringParam := Param{Name: "string", Value: "value"}
+			emptyParam := Param{Name: "empty", Value: ""}
+			objectParam := Param{Name: "object", ObjectValues: map[string]string{"key": "value"}}
+
+			stringValue := tektonv1.ParamValue{Type: tektonv1.ParamTypeString, StringVal: "value"}
+			emptyValue := tektonv1.ParamValue{Type: tektonv1.ParamTypeString}
+			objectValue := tektonv1.ParamValue{Type: tektonv1.ParamTypeObject, ObjectVal: map[string]string{"key": "value"}}
+
+			testCases := []struct {
+				description string
+				params      []Param
+				expected    []tektonv1.Param
+			}{
+				{
+					description: "string params",
+					params:      []Param{stringParam, emptyParam},
+					expected: []tektonv1.Param{
+						{Name: "string", Value: stringValue},
+						{Name: "empty", Value: emptyValue},
+					},
+				},
+				{
+					description: "object params",
+					params:      []Param{objectParam},
+					expected: []tektonv1.Param{
+						{Name: "object", Value: objectValue},
+					},
+				},
+				{
+					description: "mixed params",
+					params:      []Param{stringParam, objectParam, emptyParam},
+					expected: []tektonv1.Param{
+						{Name: "string", Value: stringValue},
+						{Name: "object", Value: objectValue},
+						{Name: "empty", Value: emptyValue},
+					},
+				},
+			}
+
+			for _, testCase := range testCases {
+				parameterizedPipeline := ParameterizedPipeline{Params: testCase.params}
+				Expect(parameterizedPipeline.GetTektonParams()).To(Equal(testCase.expected), testCase.description)
+			}
+		})
+	})
+
+	When("ValidateParams method is called", func() {
+		It("should succeed if every param defines at most one value", func() {
+			parameterizedPipeline := ParameterizedPipeline{}
+			parameterizedPipeline.Params = []Param{
+				{Name: "parameter1", Value: "value1"},
+				{Name: "parameter2", ObjectValues: map[string]string{"key": "value"}},
+			}
+			Expect(parameterizedPipeline.ValidateParams()).To(Succeed())
+		})
+
+		It("should fail if a param defines both value and objectValues", func() {
+			parameterizedPipeline := ParameterizedPipeline{}
+			parameterizedPipeline.Params = []Param{
+				{Name: "parameter1", Value: "value1", ObjectValues: map[string]string{"key": "value"}},
+			}
+			err := parameterizedPipeline.ValidateParams()
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("can't define both value and objectValues"))
+		})
+	})
+
+	When("GetWorkspaceSize method is called", func() {
+		It("should return the Pipeline WorkspaceSize if set", func() {
+			pipeline := &Pipeline{WorkspaceSize: "5Gi"}
+			Expect(pipeline.GetWorkspaceSize("1Gi")).To(Equal("5Gi"))
+		})
+
+		It("should return the default size if the Pipeline WorkspaceSize is not set", func() {
+			pipeline := &Pipeline{}
+			Expect(pipeline.GetWorkspaceSize("1Gi")).To(Equal("1Gi"))
+		})
+	})
+
 	When("IsClusterScoped method is called", func() {
 		It("should return true for a cluster pipeline", func() {
 			Expect(clusterRef.IsClusterScoped()).To(BeTrue())
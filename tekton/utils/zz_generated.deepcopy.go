@@ -22,8 +22,31 @@ package utils
 
 import (
 	"github.com/tektoncd/pipeline/pkg/apis/pipeline/v1"
+	corev1 "k8s.io/api/core/v1"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Param) DeepCopyInto(out *Param) {
+	*out = *in
+	if in.ObjectValues != nil {
+		in, out := &in.ObjectValues, &out.ObjectValues
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Param.
+func (in *Param) DeepCopy() *Param {
+	if in == nil {
+		return nil
+	}
+	out := new(Param)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ParameterizedPipeline) DeepCopyInto(out *ParameterizedPipeline) {
 	*out = *in
@@ -31,7 +54,9 @@ func (in *ParameterizedPipeline) DeepCopyInto(out *ParameterizedPipeline) {
 	if in.Params != nil {
 		in, out := &in.Params, &out.Params
 		*out = make([]Param, len(*in))
-		copy(*out, *in)
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
 }
 
@@ -48,7 +73,19 @@ func (in *ParameterizedPipeline) DeepCopy() *ParameterizedPipeline {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Pipeline) DeepCopyInto(out *Pipeline) {
 	*out = *in
+	if in.NodeSelector != nil {
+		in, out := &in.NodeSelector, &out.NodeSelector
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
 	in.PipelineRef.DeepCopyInto(&out.PipelineRef)
+	if in.SigningSecretWorkspace != nil {
+		in, out := &in.SigningSecretWorkspace, &out.SigningSecretWorkspace
+		*out = new(SecretWorkspace)
+		**out = **in
+	}
 	if in.TaskRunSpecs != nil {
 		in, out := &in.TaskRunSpecs, &out.TaskRunSpecs
 		*out = make([]v1.PipelineTaskRunSpec, len(*in))
@@ -57,6 +94,18 @@ func (in *Pipeline) DeepCopyInto(out *Pipeline) {
 		}
 	}
 	in.Timeouts.DeepCopyInto(&out.Timeouts)
+	if in.Tolerations != nil {
+		in, out := &in.Tolerations, &out.Tolerations
+		*out = make([]corev1.Toleration, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Workspaces != nil {
+		in, out := &in.Workspaces, &out.Workspaces
+		*out = make([]Workspace, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Pipeline.
@@ -75,7 +124,9 @@ func (in *PipelineRef) DeepCopyInto(out *PipelineRef) {
 	if in.Params != nil {
 		in, out := &in.Params, &out.Params
 		*out = make([]Param, len(*in))
-		copy(*out, *in)
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
 }
 
@@ -88,3 +139,33 @@ func (in *PipelineRef) DeepCopy() *PipelineRef {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SecretWorkspace) DeepCopyInto(out *SecretWorkspace) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecretWorkspace.
+func (in *SecretWorkspace) DeepCopy() *SecretWorkspace {
+	if in == nil {
+		return nil
+	}
+	out := new(SecretWorkspace)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Workspace) DeepCopyInto(out *Workspace) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Workspace.
+func (in *Workspace) DeepCopy() *Workspace {
+	if in == nil {
+		return nil
+	}
+	out := new(Workspace)
+	in.DeepCopyInto(out)
+	return out
+}
@@ -17,58 +17,137 @@ limitations under the License.
 package tekton
 
 import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/konflux-ci/release-service/api/v1alpha1"
 	"github.com/konflux-ci/release-service/metadata"
 	"github.com/konflux-ci/release-service/tekton/utils"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	tektonv1 "github.com/tektoncd/pipeline/pkg/apis/pipeline/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"knative.dev/pkg/apis"
 )
 
 var _ = Describe("Utils", Ordered, func() {
-	When("isReleasePipelineRun is called", func() {
+	When("IsReleasePipelineRun is called", func() {
 		It("should return false when the PipelineRun is not one of the supported ones", func() {
 			pipelineRun, err := utils.NewPipelineRunBuilder("pipeline-run", "default").Build()
 			Expect(err).NotTo(HaveOccurred())
-			Expect(isReleasePipelineRun(pipelineRun)).To(BeFalse())
+			Expect(IsReleasePipelineRun(pipelineRun)).To(BeFalse())
+		})
+
+		It("should return false when the PipelineRun is not associated with a Release", func() {
+			pipelineRun, err := utils.NewPipelineRunBuilder("pipeline-run", "default").
+				WithLabels(map[string]string{metadata.PipelinesTypeLabel: metadata.ManagedPipelineType.String()}).
+				Build()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(IsReleasePipelineRun(pipelineRun)).To(BeFalse())
 		})
 
 		It("should return true when the PipelineRun is of type 'tenant-collectors'", func() {
 			pipelineRun, err := utils.NewPipelineRunBuilder("pipeline-run", "default").
-				WithLabels(map[string]string{metadata.PipelinesTypeLabel: metadata.TenantCollectorsPipelineType.String()}).
+				WithLabels(map[string]string{
+					metadata.PipelinesTypeLabel: metadata.TenantCollectorsPipelineType.String(),
+					metadata.ReleaseNameLabel:   "release",
+				}).
 				Build()
 			Expect(err).NotTo(HaveOccurred())
-			Expect(isReleasePipelineRun(pipelineRun)).To(BeTrue())
+			Expect(IsReleasePipelineRun(pipelineRun)).To(BeTrue())
 		})
 
 		It("should return true when the PipelineRun is of type 'managed-collectors'", func() {
 			pipelineRun, err := utils.NewPipelineRunBuilder("pipeline-run", "default").
-				WithLabels(map[string]string{metadata.PipelinesTypeLabel: metadata.ManagedCollectorsPipelineType.String()}).
+				WithLabels(map[string]string{
+					metadata.PipelinesTypeLabel: metadata.ManagedCollectorsPipelineType.String(),
+					metadata.ReleaseNameLabel:   "release",
+				}).
 				Build()
 			Expect(err).NotTo(HaveOccurred())
-			Expect(isReleasePipelineRun(pipelineRun)).To(BeTrue())
+			Expect(IsReleasePipelineRun(pipelineRun)).To(BeTrue())
 		})
 
 		It("should return true when the PipelineRun is of type 'final'", func() {
 			pipelineRun, err := utils.NewPipelineRunBuilder("pipeline-run", "default").
-				WithLabels(map[string]string{metadata.PipelinesTypeLabel: metadata.FinalPipelineType.String()}).
+				WithLabels(map[string]string{
+					metadata.PipelinesTypeLabel: metadata.FinalPipelineType.String(),
+					metadata.ReleaseNameLabel:   "release",
+				}).
 				Build()
 			Expect(err).NotTo(HaveOccurred())
-			Expect(isReleasePipelineRun(pipelineRun)).To(BeTrue())
+			Expect(IsReleasePipelineRun(pipelineRun)).To(BeTrue())
 		})
 
 		It("should return true when the PipelineRun is of type 'managed'", func() {
 			pipelineRun, err := utils.NewPipelineRunBuilder("pipeline-run", "default").
-				WithLabels(map[string]string{metadata.PipelinesTypeLabel: metadata.ManagedPipelineType.String()}).
+				WithLabels(map[string]string{
+					metadata.PipelinesTypeLabel: metadata.ManagedPipelineType.String(),
+					metadata.ReleaseNameLabel:   "release",
+				}).
 				Build()
 			Expect(err).NotTo(HaveOccurred())
-			Expect(isReleasePipelineRun(pipelineRun)).To(BeTrue())
+			Expect(IsReleasePipelineRun(pipelineRun)).To(BeTrue())
 		})
 
 		It("should return true when the PipelineRun is of type 'tenant'", func() {
 			pipelineRun, err := utils.NewPipelineRunBuilder("pipeline-run", "default").
-				WithLabels(map[string]string{metadata.PipelinesTypeLabel: metadata.TenantPipelineType.String()}).
+				WithLabels(map[string]string{
+					metadata.PipelinesTypeLabel: metadata.TenantPipelineType.String(),
+					metadata.ReleaseNameLabel:   "release",
+				}).
 				Build()
 			Expect(err).NotTo(HaveOccurred())
-			Expect(isReleasePipelineRun(pipelineRun)).To(BeTrue())
+			Expect(IsReleasePipelineRun(pipelineRun)).To(BeTrue())
+		})
+	})
+
+	When("IsOwnedBy is called", func() {
+		var release *v1alpha1.Release
+
+		BeforeEach(func() {
+			release = &v1alpha1.Release{
+				TypeMeta: metav1.TypeMeta{
+					APIVersion: v1alpha1.GroupVersion.String(),
+					Kind:       "Release",
+				},
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "release",
+					Namespace: "default",
+				},
+			}
+		})
+
+		It("should return true when the PipelineRun is owned by the Release", func() {
+			pipelineRun, err := utils.NewPipelineRunBuilder("pipeline-run", "default").
+				WithOwner(release).
+				Build()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(IsOwnedBy(pipelineRun, release)).To(BeTrue())
+		})
+
+		It("should return false when the PipelineRun is owned by a different Release", func() {
+			otherRelease := release.DeepCopy()
+			otherRelease.Name = "other-release"
+
+			pipelineRun, err := utils.NewPipelineRunBuilder("pipeline-run", "default").
+				WithOwner(otherRelease).
+				Build()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(IsOwnedBy(pipelineRun, release)).To(BeFalse())
+		})
+
+		It("should return false when the PipelineRun has no owner annotations", func() {
+			pipelineRun, err := utils.NewPipelineRunBuilder("pipeline-run", "default").Build()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(IsOwnedBy(pipelineRun, release)).To(BeFalse())
+		})
+
+		It("should return false when the PipelineRun is nil", func() {
+			Expect(IsOwnedBy(nil, release)).To(BeFalse())
 		})
 	})
 
@@ -86,4 +165,514 @@ var _ = Describe("Utils", Ordered, func() {
 			Expect(hasPipelineSucceeded(pipelineRun)).To(BeTrue())
 		})
 	})
+
+	When("GetPipelineRunFailureReason is called", func() {
+		It("should return an empty string when the PipelineRun is running", func() {
+			pipelineRun, err := utils.NewPipelineRunBuilder("pipeline-run", "default").Build()
+			Expect(err).NotTo(HaveOccurred())
+			pipelineRun.Status.SetCondition(&apis.Condition{
+				Type:   apis.ConditionSucceeded,
+				Status: corev1.ConditionUnknown,
+			})
+			Expect(GetPipelineRunFailureReason(pipelineRun)).To(BeEmpty())
+		})
+
+		It("should return an empty string when the PipelineRun succeeded", func() {
+			pipelineRun, err := utils.NewPipelineRunBuilder("pipeline-run", "default").Build()
+			Expect(err).NotTo(HaveOccurred())
+			pipelineRun.Status.MarkSucceeded("Succeeded", "all tasks succeeded")
+			Expect(GetPipelineRunFailureReason(pipelineRun)).To(BeEmpty())
+		})
+
+		It("should return the condition message when the PipelineRun failed", func() {
+			pipelineRun, err := utils.NewPipelineRunBuilder("pipeline-run", "default").Build()
+			Expect(err).NotTo(HaveOccurred())
+			pipelineRun.Status.MarkFailed("Failed", "task %s failed", "sign")
+			Expect(GetPipelineRunFailureReason(pipelineRun)).To(Equal("task sign failed"))
+		})
+	})
+
+	When("GetPipelineName is called", func() {
+		It("should return an empty string when the PipelineRun is nil", func() {
+			Expect(GetPipelineName(nil)).To(BeEmpty())
+		})
+
+		It("should return an empty string when the PipelineRun has no PipelineRef", func() {
+			pipelineRun, err := utils.NewPipelineRunBuilder("pipeline-run", "default").Build()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(GetPipelineName(pipelineRun)).To(BeEmpty())
+		})
+
+		It("should return the name of the PipelineRef when the Pipeline is referenced by name", func() {
+			pipelineRun, err := utils.NewPipelineRunBuilder("pipeline-run", "default").
+				WithPipelineRef(&tektonv1.PipelineRef{Name: "release-pipeline"}).
+				Build()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(GetPipelineName(pipelineRun)).To(Equal("release-pipeline"))
+		})
+
+		It("should return the name resolver param when the Pipeline is referenced through the bundles resolver", func() {
+			pipelineRun, err := utils.NewPipelineRunBuilder("pipeline-run", "default").
+				WithPipelineRef(&tektonv1.PipelineRef{
+					ResolverRef: tektonv1.ResolverRef{
+						Resolver: "bundles",
+						Params: tektonv1.Params{
+							{Name: "bundle", Value: *tektonv1.NewStructuredValues("quay.io/konflux-ci/release-pipeline:latest")},
+							{Name: "kind", Value: *tektonv1.NewStructuredValues("pipeline")},
+							{Name: "name", Value: *tektonv1.NewStructuredValues("release-pipeline")},
+						},
+					},
+				}).
+				Build()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(GetPipelineName(pipelineRun)).To(Equal("release-pipeline"))
+		})
+
+		It("should return an empty string when the Pipeline is referenced through the git resolver", func() {
+			pipelineRun, err := utils.NewPipelineRunBuilder("pipeline-run", "default").
+				WithPipelineRef(utils.NewGitPipelineRef("https://github.com/org/repo", "main", "pipeline.yaml").
+					ToTektonPipelineRef()).
+				Build()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(GetPipelineName(pipelineRun)).To(BeEmpty())
+		})
+	})
+
+	When("GetPipelineRunDuration is called", func() {
+		It("should return zero when the PipelineRun is nil", func() {
+			Expect(GetPipelineRunDuration(nil)).To(BeZero())
+		})
+
+		It("should return zero when the PipelineRun has not started", func() {
+			pipelineRun, err := utils.NewPipelineRunBuilder("pipeline-run", "default").Build()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(GetPipelineRunDuration(pipelineRun)).To(BeZero())
+		})
+
+		It("should return the time elapsed since the start when the PipelineRun is running", func() {
+			pipelineRun, err := utils.NewPipelineRunBuilder("pipeline-run", "default").Build()
+			Expect(err).NotTo(HaveOccurred())
+			pipelineRun.Status.StartTime = &metav1.Time{Time: time.Now().Add(-time.Minute)}
+			Expect(GetPipelineRunDuration(pipelineRun)).To(BeNumerically(">=", time.Minute))
+		})
+
+		It("should return the time between the start and the completion when the PipelineRun succeeded", func() {
+			pipelineRun, err := utils.NewPipelineRunBuilder("pipeline-run", "default").Build()
+			Expect(err).NotTo(HaveOccurred())
+			startTime := time.Now().Add(-time.Hour)
+			pipelineRun.Status.StartTime = &metav1.Time{Time: startTime}
+			pipelineRun.Status.CompletionTime = &metav1.Time{Time: startTime.Add(5 * time.Minute)}
+			pipelineRun.Status.MarkSucceeded("Succeeded", "")
+			Expect(GetPipelineRunDuration(pipelineRun)).To(Equal(5 * time.Minute))
+		})
+
+		It("should return the time between the start and the completion when the PipelineRun timed out", func() {
+			pipelineRun, err := utils.NewPipelineRunBuilder("pipeline-run", "default").Build()
+			Expect(err).NotTo(HaveOccurred())
+			startTime := time.Now().Add(-time.Hour)
+			pipelineRun.Status.StartTime = &metav1.Time{Time: startTime}
+			pipelineRun.Status.CompletionTime = &metav1.Time{Time: startTime.Add(30 * time.Minute)}
+			pipelineRun.Status.MarkFailed(tektonv1.PipelineRunReasonTimedOut.String(), "")
+			Expect(GetPipelineRunDuration(pipelineRun)).To(Equal(30 * time.Minute))
+		})
+	})
+
+	When("GetPipelineRunFailureMessage is called", func() {
+		var taskRun, otherTaskRun, succeededTaskRun *tektonv1.TaskRun
+
+		createTaskRun := func(name string) *tektonv1.TaskRun {
+			taskRun := &tektonv1.TaskRun{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      name,
+					Namespace: "default",
+				},
+				Spec: tektonv1.TaskRunSpec{
+					TaskRef: &tektonv1.TaskRef{Name: "sign"},
+				},
+			}
+			Expect(k8sClient.Create(ctx, taskRun)).To(Succeed())
+			return taskRun
+		}
+
+		newFailedPipelineRun := func(childReferences ...tektonv1.ChildStatusReference) *tektonv1.PipelineRun {
+			pipelineRun, err := utils.NewPipelineRunBuilder("pipeline-run", "default").Build()
+			Expect(err).NotTo(HaveOccurred())
+			pipelineRun.Status.ChildReferences = childReferences
+			pipelineRun.Status.MarkFailed(tektonv1.PipelineRunReasonFailed.String(), "Tasks Completed: 3 (Failed: 2)")
+			return pipelineRun
+		}
+
+		BeforeAll(func() {
+			taskRun = createTaskRun("failed-task-run")
+			taskRun.Status.MarkResourceFailed(tektonv1.TaskRunReasonFailed, fmt.Errorf("step failed"))
+			taskRun.Status.Steps = []tektonv1.StepState{
+				{
+					Name: "sign",
+					ContainerState: corev1.ContainerState{
+						Terminated: &corev1.ContainerStateTerminated{
+							ExitCode: 1,
+							Message:  "signing key not found",
+						},
+					},
+				},
+			}
+			Expect(k8sClient.Status().Update(ctx, taskRun)).To(Succeed())
+
+			otherTaskRun = createTaskRun("other-failed-task-run")
+			otherTaskRun.Status.MarkResourceFailed(tektonv1.TaskRunReasonFailed, fmt.Errorf("push failed"))
+			otherTaskRun.Status.Steps = []tektonv1.StepState{
+				{
+					Name: "push",
+					ContainerState: corev1.ContainerState{
+						Terminated: &corev1.ContainerStateTerminated{
+							ExitCode: 2,
+						},
+					},
+				},
+			}
+			Expect(k8sClient.Status().Update(ctx, otherTaskRun)).To(Succeed())
+
+			succeededTaskRun = createTaskRun("succeeded-task-run")
+			succeededTaskRun.Status.SetCondition(&apis.Condition{
+				Type:   apis.ConditionSucceeded,
+				Status: corev1.ConditionTrue,
+			})
+			Expect(k8sClient.Status().Update(ctx, succeededTaskRun)).To(Succeed())
+		})
+
+		AfterAll(func() {
+			Expect(k8sClient.Delete(ctx, taskRun)).To(Succeed())
+			Expect(k8sClient.Delete(ctx, otherTaskRun)).To(Succeed())
+			Expect(k8sClient.Delete(ctx, succeededTaskRun)).To(Succeed())
+		})
+
+		It("should return an empty string when the PipelineRun is running", func() {
+			pipelineRun, err := utils.NewPipelineRunBuilder("pipeline-run", "default").Build()
+			Expect(err).NotTo(HaveOccurred())
+			pipelineRun.Status.SetCondition(&apis.Condition{
+				Type:   apis.ConditionSucceeded,
+				Status: corev1.ConditionUnknown,
+			})
+			Expect(GetPipelineRunFailureMessage(ctx, k8sClient, pipelineRun)).To(BeEmpty())
+		})
+
+		It("should return an empty string when the PipelineRun succeeded", func() {
+			pipelineRun, err := utils.NewPipelineRunBuilder("pipeline-run", "default").Build()
+			Expect(err).NotTo(HaveOccurred())
+			pipelineRun.Status.MarkSucceeded("Succeeded", "all tasks succeeded")
+			Expect(GetPipelineRunFailureMessage(ctx, k8sClient, pipelineRun)).To(BeEmpty())
+		})
+
+		It("should return the condition message when the PipelineRun was cancelled", func() {
+			pipelineRun, err := utils.NewPipelineRunBuilder("pipeline-run", "default").Build()
+			Expect(err).NotTo(HaveOccurred())
+			pipelineRun.Status.MarkFailed(tektonv1.PipelineRunReasonCancelled.String(), "PipelineRun was cancelled")
+			Expect(GetPipelineRunFailureMessage(ctx, k8sClient, pipelineRun)).To(Equal("PipelineRun was cancelled"))
+		})
+
+		It("should return the condition message when the PipelineRun timed out", func() {
+			pipelineRun, err := utils.NewPipelineRunBuilder("pipeline-run", "default").Build()
+			Expect(err).NotTo(HaveOccurred())
+			pipelineRun.Status.MarkFailed(tektonv1.PipelineRunReasonTimedOut.String(), "PipelineRun timed out")
+			Expect(GetPipelineRunFailureMessage(ctx, k8sClient, pipelineRun)).To(Equal("PipelineRun timed out"))
+		})
+
+		It("should return the failed task name, exit code and termination message when a task failed", func() {
+			pipelineRun := newFailedPipelineRun(tektonv1.ChildStatusReference{
+				TypeMeta:         runtime.TypeMeta{Kind: "TaskRun"},
+				Name:             taskRun.Name,
+				PipelineTaskName: "sign-image",
+			})
+			Expect(GetPipelineRunFailureMessage(ctx, k8sClient, pipelineRun)).To(
+				Equal(`task "sign-image" failed: exit code 1: signing key not found`))
+		})
+
+		It("should return all the failed tasks when several tasks failed", func() {
+			pipelineRun := newFailedPipelineRun(
+				tektonv1.ChildStatusReference{
+					TypeMeta:         runtime.TypeMeta{Kind: "TaskRun"},
+					Name:             taskRun.Name,
+					PipelineTaskName: "sign-image",
+				},
+				tektonv1.ChildStatusReference{
+					TypeMeta:         runtime.TypeMeta{Kind: "TaskRun"},
+					Name:             succeededTaskRun.Name,
+					PipelineTaskName: "verify",
+				},
+				tektonv1.ChildStatusReference{
+					TypeMeta:         runtime.TypeMeta{Kind: "TaskRun"},
+					Name:             otherTaskRun.Name,
+					PipelineTaskName: "push-advisory",
+				},
+			)
+			Expect(GetPipelineRunFailureMessage(ctx, k8sClient, pipelineRun)).To(Equal(
+				`task "sign-image" failed: exit code 1: signing key not found; ` +
+					`task "push-advisory" failed: exit code 2: push failed`))
+		})
+
+		It("should ignore the TaskRuns that can't be found", func() {
+			pipelineRun := newFailedPipelineRun(
+				tektonv1.ChildStatusReference{
+					TypeMeta:         runtime.TypeMeta{Kind: "TaskRun"},
+					Name:             "missing-task-run",
+					PipelineTaskName: "missing",
+				},
+				tektonv1.ChildStatusReference{
+					TypeMeta:         runtime.TypeMeta{Kind: "TaskRun"},
+					Name:             taskRun.Name,
+					PipelineTaskName: "sign-image",
+				},
+			)
+			Expect(GetPipelineRunFailureMessage(ctx, k8sClient, pipelineRun)).To(
+				Equal(`task "sign-image" failed: exit code 1: signing key not found`))
+		})
+
+		It("should return the condition message when none of the TaskRuns can be found", func() {
+			pipelineRun := newFailedPipelineRun(tektonv1.ChildStatusReference{
+				TypeMeta:         runtime.TypeMeta{Kind: "TaskRun"},
+				Name:             "missing-task-run",
+				PipelineTaskName: "missing",
+			})
+			Expect(GetPipelineRunFailureMessage(ctx, k8sClient, pipelineRun)).To(Equal("Tasks Completed: 3 (Failed: 2)"))
+		})
+
+		It("should truncate long messages", func() {
+			pipelineRun, err := utils.NewPipelineRunBuilder("pipeline-run", "default").Build()
+			Expect(err).NotTo(HaveOccurred())
+			pipelineRun.Status.MarkFailed(tektonv1.PipelineRunReasonFailed.String(), strings.Repeat("a", 2*maxFailureMessageLength))
+
+			message := GetPipelineRunFailureMessage(ctx, k8sClient, pipelineRun)
+			Expect(message).To(HaveLen(maxFailureMessageLength))
+			Expect(message).To(HaveSuffix("..."))
+		})
+	})
+
+	When("HasPipelineRunFailed is called", func() {
+		It("should return false when the PipelineRun is nil", func() {
+			Expect(HasPipelineRunFailed(nil)).To(BeFalse())
+		})
+
+		It("should return false when the PipelineRun is running", func() {
+			pipelineRun, err := utils.NewPipelineRunBuilder("pipeline-run", "default").Build()
+			Expect(err).NotTo(HaveOccurred())
+			pipelineRun.Status.SetCondition(&apis.Condition{
+				Type:   apis.ConditionSucceeded,
+				Status: corev1.ConditionUnknown,
+			})
+			Expect(HasPipelineRunFailed(pipelineRun)).To(BeFalse())
+		})
+
+		It("should return false when the PipelineRun succeeded", func() {
+			pipelineRun, err := utils.NewPipelineRunBuilder("pipeline-run", "default").Build()
+			Expect(err).NotTo(HaveOccurred())
+			pipelineRun.Status.MarkSucceeded("", "")
+			Expect(HasPipelineRunFailed(pipelineRun)).To(BeFalse())
+		})
+
+		It("should return true when the PipelineRun failed", func() {
+			pipelineRun, err := utils.NewPipelineRunBuilder("pipeline-run", "default").Build()
+			Expect(err).NotTo(HaveOccurred())
+			pipelineRun.Status.MarkFailed("", "")
+			Expect(HasPipelineRunFailed(pipelineRun)).To(BeTrue())
+		})
+	})
+
+	When("HasPipelineRunSucceeded is called", func() {
+		It("should return false when the PipelineRun is nil", func() {
+			Expect(HasPipelineRunSucceeded(nil)).To(BeFalse())
+		})
+
+		It("should return false when the PipelineRun is running", func() {
+			pipelineRun, err := utils.NewPipelineRunBuilder("pipeline-run", "default").Build()
+			Expect(err).NotTo(HaveOccurred())
+			pipelineRun.Status.SetCondition(&apis.Condition{
+				Type:   apis.ConditionSucceeded,
+				Status: corev1.ConditionUnknown,
+			})
+			Expect(HasPipelineRunSucceeded(pipelineRun)).To(BeFalse())
+		})
+
+		It("should return false when the PipelineRun failed", func() {
+			pipelineRun, err := utils.NewPipelineRunBuilder("pipeline-run", "default").Build()
+			Expect(err).NotTo(HaveOccurred())
+			pipelineRun.Status.MarkFailed("", "")
+			Expect(HasPipelineRunSucceeded(pipelineRun)).To(BeFalse())
+		})
+
+		It("should return true when the PipelineRun succeeded", func() {
+			pipelineRun, err := utils.NewPipelineRunBuilder("pipeline-run", "default").Build()
+			Expect(err).NotTo(HaveOccurred())
+			pipelineRun.Status.MarkSucceeded("", "")
+			Expect(HasPipelineRunSucceeded(pipelineRun)).To(BeTrue())
+		})
+	})
+
+	When("GetApplicationName is called", func() {
+		It("should return an empty string when the PipelineRun is nil", func() {
+			Expect(GetApplicationName(nil)).To(BeEmpty())
+		})
+
+		It("should return an empty string when the PipelineRun doesn't have the label", func() {
+			pipelineRun, err := utils.NewPipelineRunBuilder("pipeline-run", "default").Build()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(GetApplicationName(pipelineRun)).To(BeEmpty())
+		})
+
+		It("should return the value of the label when the PipelineRun has it", func() {
+			pipelineRun, err := utils.NewPipelineRunBuilder("pipeline-run", "default").
+				WithLabels(map[string]string{metadata.ApplicationNameLabel: "application"}).
+				Build()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(GetApplicationName(pipelineRun)).To(Equal("application"))
+		})
+	})
+
+	When("GetReleaseName is called", func() {
+		It("should return an empty string when the PipelineRun is nil", func() {
+			Expect(GetReleaseName(nil)).To(BeEmpty())
+		})
+
+		It("should return an empty string when the PipelineRun doesn't have the label", func() {
+			pipelineRun, err := utils.NewPipelineRunBuilder("pipeline-run", "default").Build()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(GetReleaseName(pipelineRun)).To(BeEmpty())
+		})
+
+		It("should return the value of the label when the PipelineRun has it", func() {
+			pipelineRun, err := utils.NewPipelineRunBuilder("pipeline-run", "default").
+				WithLabels(map[string]string{metadata.ReleaseNameLabel: "release"}).
+				Build()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(GetReleaseName(pipelineRun)).To(Equal("release"))
+		})
+	})
+
+	When("GetReleaseNamespace is called", func() {
+		It("should return an empty string when the PipelineRun is nil", func() {
+			Expect(GetReleaseNamespace(nil)).To(BeEmpty())
+		})
+
+		It("should return an empty string when the PipelineRun doesn't have the label", func() {
+			pipelineRun, err := utils.NewPipelineRunBuilder("pipeline-run", "default").Build()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(GetReleaseNamespace(pipelineRun)).To(BeEmpty())
+		})
+
+		It("should return the value of the label when the PipelineRun has it", func() {
+			pipelineRun, err := utils.NewPipelineRunBuilder("pipeline-run", "default").
+				WithLabels(map[string]string{metadata.ReleaseNamespaceLabel: "default"}).
+				Build()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(GetReleaseNamespace(pipelineRun)).To(Equal("default"))
+		})
+	})
+
+	When("GetResolvedPipelineProvenance is called", func() {
+		It("should return an empty string when the PipelineRun is nil", func() {
+			Expect(GetResolvedPipelineProvenance(nil)).To(BeEmpty())
+		})
+
+		It("should return an empty string when the PipelineRun has no provenance", func() {
+			pipelineRun, err := utils.NewPipelineRunBuilder("pipeline-run", "default").Build()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(GetResolvedPipelineProvenance(pipelineRun)).To(BeEmpty())
+		})
+
+		It("should return the uri and the sha256 digest of the resolved Pipeline", func() {
+			pipelineRun, err := utils.NewPipelineRunBuilder("pipeline-run", "default").Build()
+			Expect(err).NotTo(HaveOccurred())
+			pipelineRun.Status.Provenance = &tektonv1.Provenance{
+				RefSource: &tektonv1.RefSource{
+					URI: "quay.io/konflux-ci/release-pipeline",
+					Digest: map[string]string{
+						"sha1":   "abc",
+						"sha256": "def",
+					},
+				},
+			}
+			Expect(GetResolvedPipelineProvenance(pipelineRun)).To(Equal("quay.io/konflux-ci/release-pipeline@sha256:def"))
+		})
+
+		It("should fall back to other digest algorithms when sha256 is not present", func() {
+			pipelineRun, err := utils.NewPipelineRunBuilder("pipeline-run", "default").Build()
+			Expect(err).NotTo(HaveOccurred())
+			pipelineRun.Status.Provenance = &tektonv1.Provenance{
+				RefSource: &tektonv1.RefSource{
+					URI:    "git+https://github.com/konflux-ci/release-service-catalog.git",
+					Digest: map[string]string{"sha1": "abc"},
+				},
+			}
+			Expect(GetResolvedPipelineProvenance(pipelineRun)).To(
+				Equal("git+https://github.com/konflux-ci/release-service-catalog.git@sha1:abc"))
+		})
+
+		It("should return the uri as is when it already contains a digest", func() {
+			pipelineRun, err := utils.NewPipelineRunBuilder("pipeline-run", "default").Build()
+			Expect(err).NotTo(HaveOccurred())
+			pipelineRun.Status.Provenance = &tektonv1.Provenance{
+				RefSource: &tektonv1.RefSource{
+					URI:    "quay.io/konflux-ci/release-pipeline@sha256:def",
+					Digest: map[string]string{"sha256": "def"},
+				},
+			}
+			Expect(GetResolvedPipelineProvenance(pipelineRun)).To(Equal("quay.io/konflux-ci/release-pipeline@sha256:def"))
+		})
+	})
+	When("GetPipelineRunResult is called", func() {
+		var pipelineRun *tektonv1.PipelineRun
+
+		BeforeEach(func() {
+			var err error
+			pipelineRun, err = utils.NewPipelineRunBuilder("pipeline-run", "default").Build()
+			Expect(err).NotTo(HaveOccurred())
+			pipelineRun.Status.Results = []tektonv1.PipelineRunResult{
+				{Name: "digest", Value: *tektonv1.NewStructuredValues("sha256:abc")},
+				{Name: "images", Value: *tektonv1.NewStructuredValues("foo", "bar")},
+			}
+		})
+
+		It("should return the value of a string result", func() {
+			value, ok := GetPipelineRunResult(pipelineRun, "digest")
+			Expect(ok).To(BeTrue())
+			Expect(value).To(Equal("sha256:abc"))
+		})
+
+		It("should return array results encoded as JSON", func() {
+			value, ok := GetPipelineRunResult(pipelineRun, "images")
+			Expect(ok).To(BeTrue())
+			Expect(value).To(Equal(`["foo","bar"]`))
+		})
+
+		It("should return false when the result doesn't exist", func() {
+			value, ok := GetPipelineRunResult(pipelineRun, "missing")
+			Expect(ok).To(BeFalse())
+			Expect(value).To(BeEmpty())
+		})
+
+		It("should return false when the PipelineRun is nil", func() {
+			_, ok := GetPipelineRunResult(nil, "digest")
+			Expect(ok).To(BeFalse())
+		})
+	})
+
+	When("GetPipelineRunResults is called", func() {
+		It("should return an empty map when the PipelineRun is nil", func() {
+			Expect(GetPipelineRunResults(nil)).To(BeEmpty())
+		})
+
+		It("should return all the results of the PipelineRun", func() {
+			pipelineRun, err := utils.NewPipelineRunBuilder("pipeline-run", "default").Build()
+			Expect(err).NotTo(HaveOccurred())
+			pipelineRun.Status.Results = []tektonv1.PipelineRunResult{
+				{Name: "digest", Value: *tektonv1.NewStructuredValues("sha256:abc")},
+				{Name: "images", Value: *tektonv1.NewStructuredValues("foo", "bar")},
+				{Name: "info", Value: *tektonv1.NewObject(map[string]string{"key": "value"})},
+			}
+			Expect(GetPipelineRunResults(pipelineRun)).To(Equal(map[string]string{
+				"digest": "sha256:abc",
+				"images": `["foo","bar"]`,
+				"info":   `{"key":"value"}`,
+			}))
+		})
+	})
 })